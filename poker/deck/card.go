@@ -0,0 +1,125 @@
+package deck
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+	Cards are stored in the same 32-bit encoding the evaluator uses, so hands can be
+	scored without reparsing strings. See below:
+
+							  bitrank     suit rank   prime
+						+--------+--------+--------+--------+
+						|xxxbbbbb|bbbbbbbb|cdhsrrrr|xxpppppp|
+						+--------+--------+--------+--------+
+
+			1) p = prime number of rank (deuce=2,trey=3,four=5,...,ace=41)
+			2) r = rank of card (deuce=0,trey=1,four=2,five=3,...,ace=12)
+			3) cdhs = suit of card (bit turned on based on suit of card)
+			4) b = bit turned on depending on rank of card
+			5) x = unused
+
+	The string format, a rank followed by a suit ("As", "Td", "2c"), is only used
+	at the edges when cards are sent to clients or saved. The models store cards as
+	strings, and the server parses them with ParseCards before they're written so
+	nothing malformed is saved.
+*/
+
+// Card is a single card in the evaluator's 32-bit encoding
+type Card uint32
+
+var ErrInvalidCard = errors.New("invalid card")
+
+// rank characters indexed by rank
+const rankChars = "23456789TJQKA"
+
+// ParseCard parses a 2 character card string such as "As"
+func ParseCard(s string) (Card, error) {
+	if len(s) != 2 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidCard, s)
+	}
+	rank, ok := STRING_INT_TO_RANK[s[0]]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q unknown rank", ErrInvalidCard, s)
+	}
+	suit, ok := STRING_INT_TO_SUIT[s[1]]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q unknown suit", ErrInvalidCard, s)
+	}
+	return newCard(rank, suit), nil
+}
+
+// ParseCards parses concatenated card strings such as "AsKd"
+func ParseCards(s string) ([]Card, error) {
+	if len(s)%2 != 0 {
+		return nil, fmt.Errorf("%w: %q has an odd number of characters", ErrInvalidCard, s)
+	}
+	out := make([]Card, 0, len(s)/2)
+	for i := 0; i < len(s); i += 2 {
+		c, err := ParseCard(s[i : i+2])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+// FormatCards formats cards as concatenated card strings, the inverse of ParseCards
+func FormatCards(cards []Card) string {
+	b := strings.Builder{}
+	b.Grow(len(cards) * 2)
+	for _, c := range cards {
+		b.WriteByte(rankChars[c.Rank()])
+		b.WriteByte(c.suitChar())
+	}
+	return b.String()
+}
+
+// NewCard parses a card string, panicking if it's malformed.
+// Use ParseCard for any card string that didn't come from this package.
+func NewCard(s string) *Card {
+	c, err := ParseCard(s)
+	if err != nil {
+		panic(err)
+	}
+	return &c
+}
+
+func newCard(rank uint32, suit uint32) Card {
+	bitrank := uint32(1) << rank << 16
+	return Card(bitrank | suit<<12 | rank<<8 | PRIMES[rank])
+}
+
+// Rank is the rank of the card, deuce=0 through ace=12
+func (c Card) Rank() uint32 {
+	return uint32(c>>8) & 0xF
+}
+
+// Type is the rank character of the card
+func (c Card) Type() string {
+	return string(rankChars[c.Rank()])
+}
+
+// Suit is the suit character of the card
+func (c Card) Suit() string {
+	return string(c.suitChar())
+}
+
+func (c Card) suitChar() byte {
+	switch (c >> 12) & 0xF {
+	case 1:
+		return 's'
+	case 2:
+		return 'h'
+	case 4:
+		return 'd'
+	}
+	return 'c'
+}
+
+func (c Card) String() string {
+	return FormatCards([]Card{c})
+}
@@ -0,0 +1,91 @@
+package deck_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		Card     string
+		ExpRank  uint32
+		ExpError bool
+	}{
+		{
+			Name:    "Ace of spades",
+			Card:    "As",
+			ExpRank: 12,
+		},
+		{
+			Name:    "Deuce of clubs",
+			Card:    "2c",
+			ExpRank: 0,
+		},
+		{
+			Name:     "Unknown rank",
+			Card:     "1s",
+			ExpError: true,
+		},
+		{
+			Name:     "Unknown suit",
+			Card:     "Ax",
+			ExpError: true,
+		},
+		{
+			Name:     "Lowercase rank",
+			Card:     "ts",
+			ExpError: true,
+		},
+		{
+			Name:     "Too long",
+			Card:     "Asd",
+			ExpError: true,
+		},
+		{
+			Name:     "Empty",
+			Card:     "",
+			ExpError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			c, err := deck.ParseCard(tt.Card)
+			if tt.ExpError {
+				assert.True(t, errors.Is(err, deck.ErrInvalidCard))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpRank, c.Rank())
+			assert.Equal(t, tt.Card, c.String())
+			assert.Equal(t, tt.Card[0:1], c.Type())
+			assert.Equal(t, tt.Card[1:2], c.Suit())
+		})
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	// every card round trips through its string format
+	full := deck.New().String()
+	cards, err := deck.ParseCards(full)
+	assert.NoError(t, err)
+	assert.Equal(t, deck.FullDeckSize, len(cards))
+	assert.Equal(t, full, deck.FormatCards(cards))
+
+	_, err = deck.ParseCards("AsK")
+	assert.True(t, errors.Is(err, deck.ErrInvalidCard))
+	_, err = deck.ParseHand("AsKdQz")
+	assert.True(t, errors.Is(err, deck.ErrInvalidCard))
+	_, err = deck.ParseDeck("AsKd!!")
+	assert.True(t, errors.Is(err, deck.ErrInvalidCard))
+
+	h, err := deck.ParseHand("")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(h))
+
+	assert.Panics(t, func() { deck.NewHand("AsK") })
+}
@@ -1,40 +1,8 @@
 package deck
 
-import (
-	"fmt"
-	"math/rand"
-	"strings"
-	"time"
-)
-
 // shamelessly stolen from https://gist.github.com/montanaflynn/4cc2779d2e353d7524a7bdce57869a75
 // 		-With some slight modifications to make it work with the evaluation logic
 
-// Seed our randomness with the current time
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
-// Card holds the card suits and types in the deck
-type Card struct {
-	Type string
-	Suit string
-}
-
-func NewCard(s string) *Card {
-	rank := string(s[0])
-	suit := string(s[1])
-	card := &Card{
-		Type: rank,
-		Suit: suit,
-	}
-	return card
-}
-
-func (c *Card) String() string {
-	return fmt.Sprintf("%s%s", c.Type, c.Suit)
-}
-
 type Hand []Card
 
 type PlayerHand struct {
@@ -49,60 +17,76 @@ func (p PlayerHands) Len() int           { return len(p) }
 func (p PlayerHands) Less(i, j int) bool { return p[i].Value < p[j].Value }
 func (p PlayerHands) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
 
+// NewHand parses a hand string, panicking if it's malformed.
+// Use ParseHand for any hand that didn't come from this package.
 func NewHand(hand string) Hand {
-	out := []Card{}
-	for i, _ := range hand {
-		index := i + 1
-		if index%2 == 0 {
-			out = append(out, Card{
-				Type: string(hand[i-1]),
-				Suit: string(hand[i]),
-			})
-
-		}
+	h, err := ParseHand(hand)
+	if err != nil {
+		panic(err)
 	}
-	return out
+	return h
+}
 
+// ParseHand parses concatenated card strings into a hand
+func ParseHand(hand string) (Hand, error) {
+	return ParseCards(hand)
+}
+
+func (h Hand) String() string {
+	return FormatCards(h)
 }
 
 func (h Hand) EvaluateHand() uint32 {
-	toEval := []string{}
-	for _, c := range h {
-		toEval = append(toEval, c.String())
+	if len(h) == 7 {
+		var cards [7]Card
+		copy(cards[:], h)
+		return evaluate7(&cards)
 	}
-	return Logic(toEval)
+	return evaluate(h)
 
 }
 
 type Deck []Card
 
 func (d Deck) String() string {
-	b := strings.Builder{}
-	for _, i := range d {
-		b.WriteString(i.String())
-	}
-	return b.String()
+	return FormatCards(d)
 }
 
+// Marshal parses a deck string, panicking if it's malformed.
+// Use ParseDeck for any deck that didn't come from this package.
 func (d Deck) Marshal(deck string) Deck {
-	out := []Card{}
-	for i, _ := range deck {
-		index := i + 1
-		if index%2 == 0 {
-			out = append(out, Card{
-				Type: string(deck[i-1]),
-				Suit: string(deck[i]),
-			})
-
-		}
+	out, err := ParseDeck(deck)
+	if err != nil {
+		panic(err)
 	}
 	return out
+}
 
+// ParseDeck parses concatenated card strings into a deck
+func ParseDeck(deck string) (Deck, error) {
+	return ParseCards(deck)
 }
 
+// Deck sizes
+const (
+	FullDeckSize  = 52
+	ShortDeckSize = 36
+)
+
 func New() (deck Deck) {
 	types := []string{"2", "3", "4", "5", "6", "7",
 		"8", "9", "T", "J", "Q", "K", "A"}
+	return newDeck(types)
+}
+
+// NewShort creates a short deck (6+) which has the 2s through 5s removed
+func NewShort() (deck Deck) {
+	types := []string{"6", "7",
+		"8", "9", "T", "J", "Q", "K", "A"}
+	return newDeck(types)
+}
+
+func newDeck(types []string) (deck Deck) {
 
 	// Valid suits include Heart, Diamond, Club & Spade
 	suits := []string{"h", "d", "c", "s"}
@@ -110,38 +94,24 @@ func New() (deck Deck) {
 	// Loop over each type and suit appending to the deck
 	for i := 0; i < len(types); i++ {
 		for n := 0; n < len(suits); n++ {
-			card := Card{
-				Type: types[i],
-				Suit: suits[n],
-			}
-			deck = append(deck, card)
+			deck = append(deck, newCard(STRING_INT_TO_RANK[types[i][0]], STRING_INT_TO_SUIT[suits[n][0]]))
 		}
 	}
 	return
 }
 
-func (d Deck) IsFull() bool {
-	// 104 is a full deck (2*52)
-	if len(d) == 52 {
+// IsFull returns true if no cards have been dealt from a deck of the given size
+func (d Deck) IsFull(size int) bool {
+	if len(d) == size {
 		return true
 	}
 	return false
 
 }
 
-// Shuffle the deck
+// Shuffle the deck using a CryptoShuffler
 func Shuffle(d Deck) Deck {
-	for i := 1; i < len(d); i++ {
-		// Create a random int up to the number of cards
-		r := rand.Intn(i + 1)
-
-		// If the the current card doesn't match the random
-		// int we generated then we'll switch them out
-		if i != r {
-			d[r], d[i] = d[i], d[r]
-		}
-	}
-	return d
+	return CryptoShuffler{}.Shuffle(d)
 }
 
 // Deal a specified amount of cards
@@ -27,16 +27,16 @@ func TestDeck_Serialize(t *testing.T) {
 			d := deck.New()
 			// Upon creation the deck is not shuffled
 			assert.Equal(t, 52, len(d))
-			assert.Equal(t, "h", d[0].Suit)
-			assert.Equal(t, "2", d[0].Type)
-			assert.Equal(t, "d", d[1].Suit)
-			assert.Equal(t, "2", d[1].Type)
-			assert.Equal(t, "c", d[2].Suit)
-			assert.Equal(t, "2", d[2].Type)
-			assert.Equal(t, "s", d[3].Suit)
-			assert.Equal(t, "2", d[3].Type)
-			assert.Equal(t, "h", d[4].Suit)
-			assert.Equal(t, "3", d[4].Type)
+			assert.Equal(t, "h", d[0].Suit())
+			assert.Equal(t, "2", d[0].Type())
+			assert.Equal(t, "d", d[1].Suit())
+			assert.Equal(t, "2", d[1].Type())
+			assert.Equal(t, "c", d[2].Suit())
+			assert.Equal(t, "2", d[2].Type())
+			assert.Equal(t, "s", d[3].Suit())
+			assert.Equal(t, "2", d[3].Type())
+			assert.Equal(t, "h", d[4].Suit())
+			assert.Equal(t, "3", d[4].Type())
 
 			// serialize the deck into a string
 
@@ -50,27 +50,27 @@ func TestDeck_Serialize(t *testing.T) {
 
 			assert.Equal(t, 52, len(d))
 			assert.Equal(t, 52, len(d))
-			assert.Equal(t, "h", d[0].Suit)
-			assert.Equal(t, "2", d[0].Type)
-			assert.Equal(t, "d", d[1].Suit)
-			assert.Equal(t, "2", d[1].Type)
-			assert.Equal(t, "c", d[2].Suit)
-			assert.Equal(t, "2", d[2].Type)
-			assert.Equal(t, "s", d[3].Suit)
-			assert.Equal(t, "2", d[3].Type)
-			assert.Equal(t, "h", d[4].Suit)
-			assert.Equal(t, "3", d[4].Type)
+			assert.Equal(t, "h", d[0].Suit())
+			assert.Equal(t, "2", d[0].Type())
+			assert.Equal(t, "d", d[1].Suit())
+			assert.Equal(t, "2", d[1].Type())
+			assert.Equal(t, "c", d[2].Suit())
+			assert.Equal(t, "2", d[2].Type())
+			assert.Equal(t, "s", d[3].Suit())
+			assert.Equal(t, "2", d[3].Type())
+			assert.Equal(t, "h", d[4].Suit())
+			assert.Equal(t, "3", d[4].Type())
 
 			// verify the last card in a sorted deck
-			assert.Equal(t, "s", d[51].Suit)
-			assert.Equal(t, "A", d[51].Type)
+			assert.Equal(t, "s", d[51].Suit())
+			assert.Equal(t, "A", d[51].Type())
 
 			// Deals from the bottom of the deck
 			c, d := deck.DealCard(d)
 			// 1 less card since we dealt a card
 			assert.Equal(t, 51, len(d))
 			// Dealt card should be last card
-			assert.Equal(t, deck.Card{Type: "A", Suit: "s"}, c)
+			assert.Equal(t, *deck.NewCard("As"), c)
 
 		})
 
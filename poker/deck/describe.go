@@ -0,0 +1,249 @@
+package deck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HandCategory is the kind of 5 card hand, ordered from worst to best
+type HandCategory int
+
+const (
+	NoHand HandCategory = iota
+	HighCard
+	OnePair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+)
+
+var categoryNames = [...]string{
+	NoHand:        "No hand",
+	HighCard:      "High card",
+	OnePair:       "One pair",
+	TwoPair:       "Two pair",
+	ThreeOfAKind:  "Three of a kind",
+	Straight:      "Straight",
+	Flush:         "Flush",
+	FullHouse:     "Full house",
+	FourOfAKind:   "Four of a kind",
+	StraightFlush: "Straight flush",
+}
+
+func (c HandCategory) String() string {
+	return categoryNames[c]
+}
+
+// rank names indexed by rank, singular and plural
+var rankNames = [...][2]string{
+	{"Two", "Twos"}, {"Three", "Threes"}, {"Four", "Fours"}, {"Five", "Fives"},
+	{"Six", "Sixes"}, {"Seven", "Sevens"}, {"Eight", "Eights"}, {"Nine", "Nines"},
+	{"Ten", "Tens"}, {"Jack", "Jacks"}, {"Queen", "Queens"}, {"King", "Kings"},
+	{"Ace", "Aces"},
+}
+
+// Category maps a standard high hand score from Logic to its category
+func Category(score uint32) HandCategory {
+	switch {
+	case score == 0 || score > 7462:
+		return NoHand
+	case score <= 10:
+		return StraightFlush
+	case score <= 166:
+		return FourOfAKind
+	case score <= 322:
+		return FullHouse
+	case score <= 1599:
+		return Flush
+	case score <= 1609:
+		return Straight
+	case score <= 2467:
+		return ThreeOfAKind
+	case score <= 3325:
+		return TwoPair
+	case score <= 6185:
+		return OnePair
+	}
+	return HighCard
+}
+
+// HandDescription describes the best 5 cards of a hand
+type HandDescription struct {
+	Category HandCategory
+	// eg. "Full house, Kings full of Sevens"
+	Name string
+	// the best 5 cards, ordered by how they play
+	Cards Hand
+}
+
+// DescribeHand describes the best standard high hand using any 5 of the cards
+func (h Hand) DescribeHand() HandDescription {
+	return describe(bestFive(h, five), highRules)
+}
+
+// DescribeOmaha describes the best high hand using exactly two of the hole cards and three of the board cards
+func (h Hand) DescribeOmaha(board Hand) HandDescription {
+	if len(h) != OmahaHoleCards {
+		return describe(nil, highRules)
+	}
+	best := Hand(nil)
+	bestScore := uint32(7462 + 1)
+	for _, hc := range TWO_FROM_FOUR {
+		for _, b := range BOARDSIZE_TO_COMBINATION_MAP[len(board)] {
+			cards := Hand{h[hc[0]], h[hc[1]], board[b[0]], board[b[1]], board[b[2]]}
+			if score := five(cards); score < bestScore {
+				best, bestScore = cards, score
+			}
+		}
+	}
+	return describe(best, highRules)
+}
+
+// DescribeShortDeck describes the best hand using short deck rankings, where A-6-7-8-9 is a straight
+func (h Hand) DescribeShortDeck() HandDescription {
+	return describe(bestFive(h, five_short), shortDeckRules)
+}
+
+// DescribeRazz describes the best A-5 low, where straights and flushes don't count
+func (h Hand) DescribeRazz() HandDescription {
+	return describe(bestFive(h, five_razz), lowRules)
+}
+
+func five_razz(cards []Card) uint32 {
+	return RAZZ_LOOKUP[prime_product_from_hand(cards)]
+}
+
+// bestFive returns the 5 cards with the lowest score, or nil if there are less than 5 cards
+func bestFive(cards Hand, score func([]Card) uint32) Hand {
+	var best Hand
+	bestScore := ^uint32(0)
+	for _, hand := range hand_permutations(cards, HANDSIZE_TO_PERMUTATION_MAP[len(cards)]) {
+		if s := score(hand); s < bestScore {
+			best, bestScore = hand, s
+		}
+	}
+	return best
+}
+
+type describeRules int
+
+const (
+	highRules describeRules = iota
+	shortDeckRules
+	lowRules
+)
+
+func describe(cards Hand, rules describeRules) HandDescription {
+	if len(cards) != 5 {
+		return HandDescription{Category: NoHand, Name: NoHand.String()}
+	}
+
+	value := func(c Card) int { return int(c.Rank()) }
+	if rules == lowRules {
+		value = lowValue
+	}
+
+	// order the cards by the size of their group then by value, so KKK77 or AAJJ4
+	ordered := append(Hand{}, cards...)
+	values := make([]int, len(ordered))
+	for i, c := range ordered {
+		values[i] = value(c)
+	}
+	counts, groups := groupValues(values)
+	groupSize := map[int]int{}
+	for i, v := range groups {
+		groupSize[v] = counts[i]
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		vi, vj := value(ordered[i]), value(ordered[j])
+		if groupSize[vi] != groupSize[vj] {
+			return groupSize[vi] > groupSize[vj]
+		}
+		return vi > vj
+	})
+
+	flush, straight := false, false
+	high := ordered[0]
+	if rules != lowRules {
+		flush = true
+		for _, c := range ordered {
+			flush = flush && c.Suit() == ordered[0].Suit()
+		}
+		if len(groups) == 5 {
+			wheel := []int{12, 3, 2, 1, 0}
+			if rules == shortDeckRules {
+				wheel = []int{12, 7, 6, 5, 4}
+			}
+			if compareInts(groups, wheel) == 0 {
+				// the ace plays low
+				straight = true
+				ordered = append(ordered[1:], ordered[0])
+				high = ordered[0]
+			} else {
+				straight = groups[0]-groups[4] == 4
+			}
+		}
+	}
+
+	var category HandCategory
+	switch {
+	case straight && flush:
+		category = StraightFlush
+	case counts[0] == 4:
+		category = FourOfAKind
+	case counts[0] == 3 && counts[1] == 2:
+		category = FullHouse
+	case flush:
+		category = Flush
+	case straight:
+		category = Straight
+	case counts[0] == 3:
+		category = ThreeOfAKind
+	case counts[0] == 2 && counts[1] == 2:
+		category = TwoPair
+	case counts[0] == 2:
+		category = OnePair
+	default:
+		category = HighCard
+	}
+
+	name := func(c Card) string { return rankNames[c.Rank()][0] }
+	plural := func(c Card) string { return rankNames[c.Rank()][1] }
+
+	var detail string
+	switch category {
+	case StraightFlush:
+		if high.Rank() == 12 {
+			return HandDescription{Category: category, Name: "Royal flush", Cards: ordered}
+		}
+		detail = fmt.Sprintf("%s high", name(high))
+	case FourOfAKind, ThreeOfAKind, OnePair:
+		detail = plural(ordered[0])
+	case FullHouse:
+		detail = fmt.Sprintf("%s full of %s", plural(ordered[0]), plural(ordered[3]))
+	case TwoPair:
+		detail = fmt.Sprintf("%s and %s", plural(ordered[0]), plural(ordered[2]))
+	case Flush, Straight:
+		detail = fmt.Sprintf("%s high", name(high))
+	case HighCard:
+		if rules == lowRules {
+			ranks := []string{}
+			for _, c := range ordered {
+				ranks = append(ranks, c.Type())
+			}
+			return HandDescription{Category: category, Name: fmt.Sprintf("%s low", strings.Join(ranks, "-")), Cards: ordered}
+		} else {
+			detail = name(high)
+		}
+	}
+	return HandDescription{
+		Category: category,
+		Name:     fmt.Sprintf("%s, %s", category, detail),
+		Cards:    ordered,
+	}
+}
@@ -0,0 +1,145 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestHand_DescribeHand(t *testing.T) {
+
+	tests := []struct {
+		Name        string
+		hand        string
+		ExpCategory deck.HandCategory
+		ExpName     string
+		ExpCards    string
+	}{
+		{
+			Name:        "Royal flush",
+			hand:        "AsKsQsJsTs2d3c",
+			ExpCategory: deck.StraightFlush,
+			ExpName:     "Royal flush",
+			ExpCards:    "AsKsQsJsTs",
+		},
+		{
+			Name:        "Steel wheel",
+			hand:        "As2s3s4s5sKd",
+			ExpCategory: deck.StraightFlush,
+			ExpName:     "Straight flush, Five high",
+			ExpCards:    "5s4s3s2sAs",
+		},
+		{
+			Name:        "Quads",
+			hand:        "9h9d9c9sKhKd2c",
+			ExpCategory: deck.FourOfAKind,
+			ExpName:     "Four of a kind, Nines",
+			ExpCards:    "9h9d9c9sKh",
+		},
+		{
+			Name:        "Full house",
+			hand:        "KhKdKc7s7h2d3c",
+			ExpCategory: deck.FullHouse,
+			ExpName:     "Full house, Kings full of Sevens",
+			ExpCards:    "KhKdKc7s7h",
+		},
+		{
+			Name:        "Flush",
+			hand:        "Ah9h7h4h2hKd",
+			ExpCategory: deck.Flush,
+			ExpName:     "Flush, Ace high",
+			ExpCards:    "Ah9h7h4h2h",
+		},
+		{
+			Name:        "Wheel",
+			hand:        "Ah2d3c4s5hKd",
+			ExpCategory: deck.Straight,
+			ExpName:     "Straight, Five high",
+			ExpCards:    "5h4s3c2dAh",
+		},
+		{
+			Name:        "Straight",
+			hand:        "Th9d8c7s6h2d",
+			ExpCategory: deck.Straight,
+			ExpName:     "Straight, Ten high",
+			ExpCards:    "Th9d8c7s6h",
+		},
+		{
+			Name:        "Three of a kind",
+			hand:        "QhQdQc7s2h",
+			ExpCategory: deck.ThreeOfAKind,
+			ExpName:     "Three of a kind, Queens",
+			ExpCards:    "QhQdQc7s2h",
+		},
+		{
+			Name:        "Two pair",
+			hand:        "4hAd4cAs9h",
+			ExpCategory: deck.TwoPair,
+			ExpName:     "Two pair, Aces and Fours",
+			ExpCards:    "AdAs4h4c9h",
+		},
+		{
+			Name:        "One pair",
+			hand:        "JhJd2c7s9h",
+			ExpCategory: deck.OnePair,
+			ExpName:     "One pair, Jacks",
+			ExpCards:    "JhJd9h7s2c",
+		},
+		{
+			Name:        "High card",
+			hand:        "Kh2d5c7s9hJd3c",
+			ExpCategory: deck.HighCard,
+			ExpName:     "High card, King",
+			ExpCards:    "KhJd9h7s5c",
+		},
+		{
+			Name:        "Not enough cards",
+			hand:        "KhKd",
+			ExpCategory: deck.NoHand,
+			ExpName:     "No hand",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			h := deck.NewHand(tt.hand)
+			d := h.DescribeHand()
+			assert.Equal(t, tt.ExpCategory, d.Category)
+			assert.Equal(t, tt.ExpName, d.Name)
+			assert.Equal(t, tt.ExpCards, d.Cards.String())
+			if tt.ExpCategory != deck.NoHand {
+				assert.Equal(t, tt.ExpCategory, deck.Category(h.EvaluateHand()))
+				assert.Equal(t, h.EvaluateHand(), d.Cards.EvaluateHand())
+			}
+		})
+	}
+}
+
+func TestHand_DescribeVariants(t *testing.T) {
+	d := deck.NewHand("AhKc2c3d").DescribeOmaha(deck.NewHand("QhJhTh9h8h"))
+	// exactly 2 hole cards play, so one heart in hand doesn't make a flush
+	assert.Equal(t, deck.Straight, d.Category)
+	assert.Equal(t, "Straight, Ace high", d.Name)
+
+	d = deck.NewHand("Ah6d7c8s9hKd").DescribeShortDeck()
+	assert.Equal(t, deck.Straight, d.Category)
+	assert.Equal(t, "Straight, Nine high", d.Name)
+	assert.Equal(t, "9h8s7c6dAh", d.Cards.String())
+
+	d = deck.NewHand("Kh5s4s3s2sAsKd").DescribeRazz()
+	assert.Equal(t, deck.HighCard, d.Category)
+	assert.Equal(t, "5-4-3-2-A low", d.Name)
+
+	d = deck.NewHand("2h2d3c3s4h4dKs").DescribeRazz()
+	assert.Equal(t, deck.OnePair, d.Category)
+	assert.Equal(t, "One pair, Twos", d.Name)
+}
+
+func TestCategory_MatchesDescription(t *testing.T) {
+	for _, hand := range randomHands(2000) {
+		h := deck.Hand(hand[:])
+		d := h.DescribeHand()
+		assert.Equal(t, deck.Category(h.EvaluateHand()), d.Category, h.String())
+		assert.Equal(t, h.EvaluateHand(), d.Cards.EvaluateHand(), h.String())
+	}
+}
@@ -0,0 +1,304 @@
+package deck
+
+import (
+	"errors"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+/*
+   Hold'em equity calculator.
+
+   Every remaining board is enumerated when there are few enough of them, otherwise
+   boards are sampled Monte Carlo. The work is split into fixed chunks that are spread
+   across goroutines, each Monte Carlo chunk has its own rand seeded from the seed and the
+   chunk number, so the same seed gives the same result no matter how many workers run.
+*/
+
+const (
+	DefaultEquityIterations = 100000
+	// heads up preflop has C(48, 5) = 1,712,304 boards, which are enumerated
+	DefaultExhaustiveLimit = 2000000
+
+	equityChunkSize = 4096
+)
+
+var (
+	ErrEquityHands   = errors.New("equity needs at least 2 hands of 2 cards")
+	ErrEquityBoard   = errors.New("board can have at most 5 cards")
+	ErrDuplicateCard = errors.New("card is used more than once")
+)
+
+// EquityOptions configures CalculateEquity, zero values use the defaults
+type EquityOptions struct {
+	// number of boards sampled when the boards aren't enumerated
+	Iterations int
+	// boards are enumerated when there are at most this many left
+	ExhaustiveLimit int
+	Seed            int64
+	// goroutines to run, defaults to the number of CPUs
+	Workers int
+}
+
+// HandEquity is the share of boards a hand wins or ties as percentages.
+// Equity counts a tie between n hands as 1/n of a win.
+type HandEquity struct {
+	Win    float64
+	Tie    float64
+	Equity float64
+}
+
+type EquityResult struct {
+	Hands      []HandEquity
+	Boards     int
+	Exhaustive bool
+}
+
+// CalculateEquity calculates the hold'em equity of each hand against the others
+// given the known board cards and any dead cards that can't come on the board
+func CalculateEquity(hands []Hand, board Hand, dead Hand, opts EquityOptions) (EquityResult, error) {
+	if len(hands) < 2 {
+		return EquityResult{}, ErrEquityHands
+	}
+	if len(board) > 5 {
+		return EquityResult{}, ErrEquityBoard
+	}
+
+	used := map[Card]bool{}
+	known := append(append(Hand{}, board...), dead...)
+	for _, h := range hands {
+		if len(h) != 2 {
+			return EquityResult{}, ErrEquityHands
+		}
+		known = append(known, h...)
+	}
+	for _, c := range known {
+		if used[c] {
+			return EquityResult{}, ErrDuplicateCard
+		}
+		used[c] = true
+	}
+
+	remaining := Deck{}
+	for _, c := range New() {
+		if !used[c] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	if opts.Iterations <= 0 {
+		opts.Iterations = DefaultEquityIterations
+	}
+	if opts.ExhaustiveLimit <= 0 {
+		opts.ExhaustiveLimit = DefaultExhaustiveLimit
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+
+	e := &equityRun{
+		hands:     hands,
+		board:     board,
+		remaining: remaining,
+		toDeal:    5 - len(board),
+	}
+
+	var chunks int
+	var run func(chunk int, t *equityTally)
+	exhaustive := binomial(len(remaining), e.toDeal) <= opts.ExhaustiveLimit
+	if exhaustive {
+		// chunk on the index of the first card dealt
+		chunks = len(remaining)
+		if e.toDeal == 0 {
+			chunks = 1
+		}
+		run = e.enumerate
+	} else {
+		chunks = (opts.Iterations + equityChunkSize - 1) / equityChunkSize
+		run = func(chunk int, t *equityTally) {
+			n := equityChunkSize
+			if chunk == chunks-1 {
+				n = opts.Iterations - chunk*equityChunkSize
+			}
+			e.sample(rand.New(rand.NewSource(opts.Seed+int64(chunk))), n, t)
+		}
+	}
+
+	total := runEquityChunks(chunks, opts.Workers, len(hands), run)
+	return total.result(exhaustive), nil
+}
+
+// runEquityChunks runs every chunk across the workers and sums their tallies
+func runEquityChunks(chunks, workers, hands int, run func(chunk int, t *equityTally)) *equityTally {
+	tallies := make([]*equityTally, chunks)
+	work := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range work {
+				t := newEquityTally(hands)
+				run(chunk, t)
+				tallies[chunk] = t
+			}
+		}()
+	}
+	for chunk := 0; chunk < chunks; chunk++ {
+		work <- chunk
+	}
+	close(work)
+	wg.Wait()
+
+	// sum in chunk order so floating point ties add up the same every run
+	total := newEquityTally(hands)
+	for _, t := range tallies {
+		total.add(t)
+	}
+	return total
+}
+
+type equityRun struct {
+	hands     []Hand
+	board     Hand
+	remaining Deck
+	toDeal    int
+}
+
+type equityTally struct {
+	boards int
+	// each board counts as weight, the sum of the weights is total
+	weight float64
+	total  float64
+	wins   []float64
+	ties   []float64
+	shares []float64
+	// scratch space reused for every board
+	scores []uint32
+	board  [5]Card
+}
+
+func newEquityTally(hands int) *equityTally {
+	return &equityTally{
+		weight: 1,
+		wins:   make([]float64, hands),
+		ties:   make([]float64, hands),
+		shares: make([]float64, hands),
+		scores: make([]uint32, hands),
+	}
+}
+
+func (t *equityTally) add(o *equityTally) {
+	t.boards += o.boards
+	t.total += o.total
+	for i := range t.wins {
+		t.wins[i] += o.wins[i]
+		t.ties[i] += o.ties[i]
+		t.shares[i] += o.shares[i]
+	}
+}
+
+// enumerate tallies every board where the first card dealt is remaining[first]
+func (e *equityRun) enumerate(first int, t *equityTally) {
+	copy(t.board[:], e.board)
+	if e.toDeal == 0 {
+		e.score(t)
+		return
+	}
+
+	var deal func(depth, start int)
+	deal = func(depth, start int) {
+		if depth == e.toDeal {
+			e.score(t)
+			return
+		}
+		for i := start; i <= len(e.remaining)-(e.toDeal-depth); i++ {
+			t.board[len(e.board)+depth] = e.remaining[i]
+			deal(depth+1, i+1)
+		}
+	}
+	if first > len(e.remaining)-e.toDeal {
+		return
+	}
+	t.board[len(e.board)] = e.remaining[first]
+	deal(1, first+1)
+}
+
+// sample tallies n random boards
+func (e *equityRun) sample(r *rand.Rand, n int, t *equityTally) {
+	copy(t.board[:], e.board)
+	cards := append(Deck{}, e.remaining...)
+	for ; n > 0; n-- {
+		// partial fisher-yates, only the cards needed for the board are shuffled
+		for i := 0; i < e.toDeal; i++ {
+			j := i + r.Intn(len(cards)-i)
+			cards[i], cards[j] = cards[j], cards[i]
+			t.board[len(e.board)+i] = cards[i]
+		}
+		e.score(t)
+	}
+}
+
+func (e *equityRun) score(t *equityTally) {
+	var cards [7]Card
+	copy(cards[2:], t.board[:])
+
+	best := uint32(7462 + 1)
+	winners := 0
+	for i, h := range e.hands {
+		cards[0], cards[1] = h[0], h[1]
+		t.scores[i] = evaluate7(&cards)
+		if t.scores[i] < best {
+			best, winners = t.scores[i], 1
+		} else if t.scores[i] == best {
+			winners++
+		}
+	}
+
+	t.boards++
+	t.total += t.weight
+	for i, s := range t.scores {
+		if s != best {
+			continue
+		}
+		if winners == 1 {
+			t.wins[i] += t.weight
+		} else {
+			t.ties[i] += t.weight
+			t.shares[i] += t.weight / float64(winners)
+		}
+	}
+}
+
+// result converts the tally to percentages
+func (t *equityTally) result(exhaustive bool) EquityResult {
+	out := EquityResult{
+		Hands:      make([]HandEquity, len(t.wins)),
+		Boards:     t.boards,
+		Exhaustive: exhaustive,
+	}
+	if t.total == 0 {
+		return out
+	}
+	for i := range t.wins {
+		out.Hands[i] = HandEquity{
+			Win:    100 * t.wins[i] / t.total,
+			Tie:    100 * t.ties[i] / t.total,
+			Equity: 100 * (t.wins[i] + t.shares[i]) / t.total,
+		}
+	}
+	return out
+}
+
+// binomial returns C(n, k)
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	out := 1
+	for i := 0; i < k; i++ {
+		out = out * (n - i) / (i + 1)
+	}
+	return out
+}
@@ -0,0 +1,135 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestCalculateEquity(t *testing.T) {
+
+	tests := []struct {
+		Name          string
+		Hands         []string
+		Board         string
+		Dead          string
+		ExpExhaustive bool
+		ExpBoards     int
+		ExpWin        []float64
+		ExpTie        []float64
+		ExpEquity     []float64
+		ExpError      error
+	}{
+		{
+			Name:          "Flush and overcard draw on the turn",
+			Hands:         []string{"AhKh", "QsQd"},
+			Board:         "2h7h9cTs",
+			ExpExhaustive: true,
+			ExpBoards:     44,
+			ExpWin:        []float64{100 * 15.0 / 44, 100 * 29.0 / 44},
+			ExpTie:        []float64{0, 0},
+			ExpEquity:     []float64{100 * 15.0 / 44, 100 * 29.0 / 44},
+		},
+		{
+			Name:          "Dead cards are removed from the outs",
+			Hands:         []string{"AhKh", "QsQd"},
+			Board:         "2h7h9cTs",
+			Dead:          "3h4h",
+			ExpExhaustive: true,
+			ExpBoards:     42,
+			ExpWin:        []float64{100 * 13.0 / 42, 100 * 29.0 / 42},
+			ExpTie:        []float64{0, 0},
+			ExpEquity:     []float64{100 * 13.0 / 42, 100 * 29.0 / 42},
+		},
+		{
+			Name:          "Board plays for a chopped pot",
+			Hands:         []string{"2c3d", "2d3c", "4s5s"},
+			Board:         "AhKhQhJhTh",
+			ExpExhaustive: true,
+			ExpBoards:     1,
+			ExpWin:        []float64{0, 0, 0},
+			ExpTie:        []float64{100, 100, 100},
+			ExpEquity:     []float64{100.0 / 3, 100.0 / 3, 100.0 / 3},
+		},
+		{
+			Name:     "Duplicate cards",
+			Hands:    []string{"AhKh", "AhQd"},
+			ExpError: deck.ErrDuplicateCard,
+		},
+		{
+			Name:     "Only one hand",
+			Hands:    []string{"AhKh"},
+			ExpError: deck.ErrEquityHands,
+		},
+		{
+			Name:     "Too many board cards",
+			Hands:    []string{"AhKh", "QsQd"},
+			Board:    "2c3c4c5c6c7c",
+			ExpError: deck.ErrEquityBoard,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			hands := []deck.Hand{}
+			for _, h := range tt.Hands {
+				hands = append(hands, deck.NewHand(h))
+			}
+			out, err := deck.CalculateEquity(hands, deck.NewHand(tt.Board), deck.NewHand(tt.Dead), deck.EquityOptions{})
+			if tt.ExpError != nil {
+				assert.Equal(t, tt.ExpError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpExhaustive, out.Exhaustive)
+			assert.Equal(t, tt.ExpBoards, out.Boards)
+			for i, h := range out.Hands {
+				assert.InDelta(t, tt.ExpWin[i], h.Win, 1e-9)
+				assert.InDelta(t, tt.ExpTie[i], h.Tie, 1e-9)
+				assert.InDelta(t, tt.ExpEquity[i], h.Equity, 1e-9)
+			}
+		})
+	}
+}
+
+func TestCalculateEquity_Preflop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping preflop enumeration in short mode")
+	}
+	hands := []deck.Hand{deck.NewHand("AhAs"), deck.NewHand("KdKc")}
+	out, err := deck.CalculateEquity(hands, nil, nil, deck.EquityOptions{})
+	assert.NoError(t, err)
+	assert.True(t, out.Exhaustive)
+	assert.Equal(t, 1712304, out.Boards)
+	assert.InDelta(t, 82, out.Hands[0].Equity, 1)
+	assert.InDelta(t, 100, out.Hands[0].Equity+out.Hands[1].Equity, 1e-9)
+}
+
+func TestCalculateEquity_MonteCarlo(t *testing.T) {
+	hands := []deck.Hand{deck.NewHand("AhAs"), deck.NewHand("KdKc"), deck.NewHand("7c2d")}
+	opts := deck.EquityOptions{Iterations: 20000, ExhaustiveLimit: 1, Seed: 7, Workers: 1}
+
+	out, err := deck.CalculateEquity(hands, nil, nil, opts)
+	assert.NoError(t, err)
+	assert.False(t, out.Exhaustive)
+	assert.Equal(t, 20000, out.Boards)
+	assert.InDelta(t, 71.2, out.Hands[0].Equity, 1.5)
+
+	// the same seed gives the same result no matter how many workers run
+	opts.Workers = 4
+	parallel, err := deck.CalculateEquity(hands, nil, nil, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, out, parallel)
+
+	opts.Seed = 8
+	reseeded, err := deck.CalculateEquity(hands, nil, nil, opts)
+	assert.NoError(t, err)
+	assert.NotEqual(t, out, reseeded)
+}
+
+func BenchmarkCalculateEquity(b *testing.B) {
+	hands := []deck.Hand{deck.NewHand("AhAs"), deck.NewHand("KdKc")}
+	for i := 0; i < b.N; i++ {
+		deck.CalculateEquity(hands, deck.NewHand("2h7h9c"), nil, deck.EquityOptions{})
+	}
+}
@@ -112,12 +112,13 @@ func init() {
 	}
 }
 
+// Logic scores the best 5 card hand of the card strings.
+// Panics if a card string is malformed, use ParseCards to validate untrusted input.
 func Logic(args []string) uint32 {
-	cards := make([]uint32, len(args))
-	for i := 0; i < len(args); i++ {
-		cards[i] = make_card(args[i])
-	}
+	return evaluate(cardsFromStrings(args))
+}
 
+func evaluate(cards []Card) uint32 {
 	// get the permutations and the evaluation function
 	possible_hands := hand_permutations(cards, HANDSIZE_TO_PERMUTATION_MAP[len(cards)])
 
@@ -131,10 +132,10 @@ func Logic(args []string) uint32 {
 	return best_score
 }
 
-func five(cards []uint32) uint32 {
+func five(cards []Card) uint32 {
 	if cards[0]&cards[1]&cards[2]&cards[3]&cards[4]&0xF000 != 0 {
 		// if flush
-		handOR := (cards[0] | cards[1] | cards[2] | cards[3] | cards[4]) >> 16
+		handOR := uint32(cards[0]|cards[1]|cards[2]|cards[3]|cards[4]) >> 16
 		prime := prime_product_from_rankbits(handOR)
 		return FLUSH_LOOKUP[prime]
 	} else {
@@ -144,51 +145,31 @@ func five(cards []uint32) uint32 {
 	}
 }
 
-func make_card(cardstring string) uint32 {
-	/*
-		Cards are 32-bit integers, so there is no object instantiation -
-		they are just ints. Most of the bits are used, and have a specific meaning.
-		See below:
-										Card:
-
-							  bitrank     suit rank   prime
-						+--------+--------+--------+--------+
-						|xxxbbbbb|bbbbbbbb|cdhsrrrr|xxpppppp|
-						+--------+--------+--------+--------+
-
-			1) p = prime number of rank (deuce=2,trey=3,four=5,...,ace=41)
-			2) r = rank of card (deuce=0,trey=1,four=2,five=3,...,ace=12)
-			3) cdhs = suit of card (bit turned on based on suit of card)
-			4) b = bit turned on depending on rank of card
-			5) x = unused
-
-		This representation will allow us to do very important things like:
-		- Make a unique prime prodcut for each hand
-		- Detect flushes
-		- Detect straights
-
-		and is also quite performant.
-	*/
+// make_card parses a single card string into the evaluator's encoding, see Card
+func make_card(cardstring string) Card {
+	return *NewCard(cardstring)
+}
 
-	rank := STRING_INT_TO_RANK[cardstring[0]]
-	rankprime := STRING_INT_TO_PRIME[cardstring[0]]
-	bitrank := uint32(1) << rank << 16
-	suit := STRING_INT_TO_SUIT[cardstring[1]] << 12
-	return bitrank | suit | (rank << 8) | rankprime
+func cardsFromStrings(args []string) []Card {
+	cards := make([]Card, len(args))
+	for i := 0; i < len(args); i++ {
+		cards[i] = make_card(args[i])
+	}
+	return cards
 }
 
-func prime_product_from_hand(cards []uint32) uint32 {
+func prime_product_from_hand(cards []Card) uint32 {
 	product := uint32(1)
 	for _, card := range cards {
-		product *= (card & 0xFF)
+		product *= uint32(card & 0xFF)
 	}
 	return product
 }
 
-func hand_permutations(cards []uint32, permutation_indices [][5]uint8) [][]uint32 {
-	permutations := make([][]uint32, len(permutation_indices))
+func hand_permutations(cards []Card, permutation_indices [][5]uint8) [][]Card {
+	permutations := make([][]Card, len(permutation_indices))
 	for i, card_indices := range permutation_indices {
-		permutations[i] = make([]uint32, 5)
+		permutations[i] = make([]Card, 5)
 		for j, card_index := range card_indices {
 			permutations[i][j] = cards[card_index]
 		}
@@ -0,0 +1,164 @@
+package deck
+
+import "math/bits"
+
+/*
+   Direct 7 card evaluator.
+
+   Logic scores 7 cards by looking up all 21 five card subsets. For simulations
+   Evaluate7 instead does 1 lookup into tables that are precomputed from the 5 card
+   lookups at init, without allocating. Scores are the same [1, 7462] ranking.
+
+   With 7 cards at most one suit can have 5 or more cards, and when it does the
+   hand can't also hold quads or a full house, so the flush is the best hand:
+   - flush7 maps the rank bits of the flush suit to the best flush or straight flush
+   - unsuited7 maps the multiset of the 7 ranks to the best unsuited hand
+
+   The multiset of ranks r0 <= r1 <= ... <= r6 is indexed with the combinatorial
+   number system by making the ranks strictly increasing: sum(C(ri + i, i + 1)).
+   There are C(13 + 7 - 1, 7) = 50388 multisets, some impossible (5+ of a rank).
+*/
+
+const (
+	rankBitsCount     = 1 << 13
+	rankMultisetCount = 50388
+)
+
+// built from the raw 5 card lookups so they're initialized in dependency order, not init order
+var (
+	choose    = binomials()
+	flush7    = flush7_lookup()
+	unsuited7 = unsuited7_lookup()
+)
+
+// Evaluate7 scores the best 5 card hand of exactly 7 cards
+func Evaluate7(cards [7]Card) uint32 {
+	return evaluate7(&cards)
+}
+
+func evaluate7(cards *[7]Card) uint32 {
+	var counts [13]uint8
+	// indexed by the suit bits
+	var suitCounts [9]uint8
+	var suitRankBits [9]uint32
+	rankBits := uint32(0)
+
+	for _, c := range cards {
+		suit := (c >> 12) & 0xF
+		suitCounts[suit]++
+		suitRankBits[suit] |= uint32(c) >> 16
+		rankBits |= uint32(c) >> 16
+		counts[(c>>8)&0xF]++
+	}
+
+	switch {
+	case suitCounts[1] >= 5:
+		return uint32(flush7[suitRankBits[1]])
+	case suitCounts[2] >= 5:
+		return uint32(flush7[suitRankBits[2]])
+	case suitCounts[4] >= 5:
+		return uint32(flush7[suitRankBits[4]])
+	case suitCounts[8] >= 5:
+		return uint32(flush7[suitRankBits[8]])
+	}
+	return uint32(unsuited7[multisetIndex(&counts, rankBits)])
+}
+
+// binomials returns C(n, k) for the largest multiset index, C(12 + 6, 7)
+func binomials() (out [19][8]uint32) {
+	for n := 0; n < len(out); n++ {
+		out[n][0] = 1
+		for k := 1; k < len(out[n]) && k <= n; k++ {
+			out[n][k] = out[n-1][k-1] + out[n-1][k]
+		}
+	}
+	return out
+}
+
+// multisetIndex indexes the ranks in the combinatorial number system,
+// rankBits has a bit set for each rank with a count so only those ranks are visited
+func multisetIndex(counts *[13]uint8, rankBits uint32) uint32 {
+	index := uint32(0)
+	i := 0
+	for ; rankBits != 0; rankBits &= rankBits - 1 {
+		rank := bits.TrailingZeros32(rankBits)
+		for n := counts[rank]; n > 0; n-- {
+			index += choose[rank+i][i+1]
+			i++
+		}
+	}
+	return index
+}
+
+// flush7_lookup ranks the best flush for every set of 5 to 7 ranks of one suit
+func flush7_lookup() (out [rankBitsCount]uint16) {
+	subsets := map[int][][5]uint8{
+		5: FIVE_CHOOSE_FIVE,
+		6: SIX_CHOOSE_FIVE,
+		7: SEVEN_CHOOSE_FIVE,
+	}
+	for rankbits := 0; rankbits < rankBitsCount; rankbits++ {
+		ranks := []uint32{}
+		for r := uint32(0); r < 13; r++ {
+			if rankbits&(1<<r) != 0 {
+				ranks = append(ranks, r)
+			}
+		}
+		if len(ranks) < 5 {
+			continue
+		}
+
+		best := uint32(7462)
+		for _, subset := range subsets[len(ranks)] {
+			bits := uint32(0)
+			for _, i := range subset {
+				bits |= 1 << ranks[i]
+			}
+			if score := flush_lookup[prime_product_from_rankbits(bits)]; score < best {
+				best = score
+			}
+		}
+		out[rankbits] = uint16(best)
+	}
+	return out
+}
+
+// unsuited7_lookup ranks the best unsuited hand for every multiset of 7 ranks
+func unsuited7_lookup() (out [rankMultisetCount]uint16) {
+	var counts [13]uint8
+	ranks := make([]uint32, 0, 7)
+
+	var gen func(start uint32)
+	gen = func(start uint32) {
+		if len(ranks) == 7 {
+			best := uint32(7462)
+			for _, subset := range SEVEN_CHOOSE_FIVE {
+				prime := uint32(1)
+				for _, i := range subset {
+					prime *= PRIMES[ranks[i]]
+				}
+				if score := unsuited_lookup[prime]; score < best {
+					best = score
+				}
+			}
+			rankBits := uint32(0)
+			for _, r := range ranks {
+				rankBits |= 1 << r
+			}
+			out[multisetIndex(&counts, rankBits)] = uint16(best)
+			return
+		}
+		for r := start; r < 13; r++ {
+			if counts[r] == 4 {
+				continue
+			}
+			counts[r]++
+			ranks = append(ranks, r)
+			gen(r)
+			ranks = ranks[:len(ranks)-1]
+			counts[r]--
+		}
+	}
+	gen(0)
+	return out
+}
@@ -0,0 +1,152 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func TestEvaluate7(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		hand     string
+		ExpScore uint32
+	}{
+		{
+			Name:     "Royal flush",
+			hand:     "AsKsQsJsTs2d3c",
+			ExpScore: 1,
+		},
+		{
+			Name:     "Steel wheel with a higher flush card",
+			hand:     "As2s3s4s5sKsKd",
+			ExpScore: 10,
+		},
+		{
+			Name:     "Quads over a full house",
+			hand:     "AhAdAcAsKhKdKc",
+			ExpScore: 11,
+		},
+		{
+			Name:     "Worst 7 card hand",
+			hand:     "2h3d4c5s7h8d9c",
+			ExpScore: deck.Logic([]string{"2h", "3d", "4c", "5s", "7h", "8d", "9c"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			var cards [7]deck.Card
+			copy(cards[:], deck.NewHand(tt.hand))
+			assert.Equal(t, int(tt.ExpScore), int(deck.Evaluate7(cards)))
+			assert.Equal(t, int(tt.ExpScore), int(deck.NewHand(tt.hand).EvaluateHand()))
+		})
+	}
+
+	var cards [7]deck.Card
+	copy(cards[:], deck.NewHand("AsKsQsJsTs2d3c"))
+	assert.Equal(t, float64(0), testing.AllocsPerRun(100, func() {
+		deck.Evaluate7(cards)
+	}))
+}
+
+// TestEvaluate7_Exhaustive checks every one of the 133,784,560 seven card hands against Logic.
+// Logic is only run once per distinct hand, which is the ranks of the flush suit if the hand
+// has a flush, otherwise the count of each rank, as suits can't matter to either evaluator otherwise.
+func TestEvaluate7_Exhaustive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping exhaustive evaluation in short mode")
+	}
+
+	d := deck.New()
+	expected := map[uint64]uint32{}
+	hands := 0
+
+	var cards [7]deck.Card
+	var idx [7]int
+	var walk func(depth, start int)
+	walk = func(depth, start int) {
+		if depth == 7 {
+			hands++
+			for i, j := range idx {
+				cards[i] = d[j]
+			}
+			key := distinctHand(cards)
+			exp, ok := expected[key]
+			if !ok {
+				strs := make([]string, 7)
+				for i, c := range cards {
+					strs[i] = c.String()
+				}
+				exp = deck.Logic(strs)
+				expected[key] = exp
+			}
+			if score := deck.Evaluate7(cards); score != exp {
+				t.Fatalf("%v scored %d expected %d", cards, score, exp)
+			}
+			return
+		}
+		for i := start; i <= len(d)-(7-depth); i++ {
+			idx[depth] = i
+			walk(depth+1, i+1)
+		}
+	}
+	walk(0, 0)
+	assert.Equal(t, 133784560, hands)
+}
+
+func distinctHand(cards [7]deck.Card) uint64 {
+	var suitRanks [4]uint64
+	var suitCounts [4]int
+	key := uint64(0)
+	for _, c := range cards {
+		// the suit bits of the card's encoding, s=1 h=2 d=4 c=8
+		suit := bits.TrailingZeros32(uint32(c) >> 12 & 0xF)
+		suitRanks[suit] |= 1 << c.Rank()
+		suitCounts[suit]++
+		key += 1 << (4 * c.Rank())
+	}
+	for suit, n := range suitCounts {
+		if n >= 5 {
+			return 1<<63 | suitRanks[suit]
+		}
+	}
+	return key
+}
+
+func randomHands(n int) [][7]deck.Card {
+	r := rand.New(rand.NewSource(1))
+	hands := make([][7]deck.Card, n)
+	for i := range hands {
+		d := deck.NewSeededShuffler(r.Int63()).Shuffle(deck.New())
+		copy(hands[i][:], d)
+	}
+	return hands
+}
+
+func BenchmarkLogic(b *testing.B) {
+	hands := randomHands(1000)
+	strs := make([][]string, len(hands))
+	for i, h := range hands {
+		for _, c := range h {
+			strs[i] = append(strs[i], c.String())
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deck.Logic(strs[i%len(strs)])
+	}
+}
+
+func BenchmarkEvaluate7(b *testing.B) {
+	hands := randomHands(1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deck.Evaluate7(hands[i%len(hands)])
+	}
+}
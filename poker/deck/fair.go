@@ -0,0 +1,82 @@
+package deck
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// Provably fair dealing
+//
+// The deck order is derived entirely from a secret server seed and an optional client seed.
+// The server publishes a commitment, a sha256 of the server seed, before the client seed is
+// accepted, so it can't go looking for a seed that deals the deck it wants once it knows the
+// client seed. Once the hand is over the server seed is revealed and anyone can check it against
+// the commitment and recompute the deck with Verify.
+
+// NewServerSeed draws a new secret server seed from the shuffler.
+// A shuffled deck holds ~225 bits of entropy, which is hashed down to a 256 bit hex seed,
+// so the seed is as unpredictable as the shuffler and deterministic with a SeededShuffler.
+func NewServerSeed(s Shuffler) string {
+	sum := sha256.Sum256([]byte(s.Shuffle(New()).String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// FairShuffle is a Fisher-Yates shuffle driven by HMAC-SHA256(server seed, client seed:counter),
+// the same seeds will always give the same order for the same starting deck
+func FairShuffle(d Deck, serverSeed, clientSeed string) Deck {
+	r := &fairRand{serverSeed: serverSeed, clientSeed: clientSeed}
+	for i := len(d) - 1; i > 0; i-- {
+		j := r.intn(i + 1)
+		d[i], d[j] = d[j], d[i]
+	}
+	return d
+}
+
+// Commitment is the hash of the server seed published before the client seed is accepted
+func Commitment(serverSeed string) string {
+	sum := sha256.Sum256([]byte(serverSeed))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify recomputes the shuffle of the unshuffled deck from the revealed seeds, and checks the
+// server seed is the one committed to before the hand. The commitment can't cover the client seed
+// or the deck order, so the deck has to be checked against the cards that were dealt
+func Verify(unshuffled Deck, serverSeed, clientSeed, commitment string) (Deck, bool) {
+	d := FairShuffle(append(Deck{}, unshuffled...), serverSeed, clientSeed)
+	return d, Commitment(serverSeed) == commitment
+}
+
+// fairRand is a stream of random numbers generated by hmac'ing an incrementing counter
+type fairRand struct {
+	serverSeed string
+	clientSeed string
+	counter    uint64
+	buf        []byte
+}
+
+func (r *fairRand) uint32() uint32 {
+	if len(r.buf) < 4 {
+		mac := hmac.New(sha256.New, []byte(r.serverSeed))
+		fmt.Fprintf(mac, "%s:%d", r.clientSeed, r.counter)
+		r.counter++
+		r.buf = mac.Sum(nil)
+	}
+	n := binary.BigEndian.Uint32(r.buf)
+	r.buf = r.buf[4:]
+	return n
+}
+
+// intn returns a uniform number in [0,n), rejecting values that would bias the modulo
+func (r *fairRand) intn(n int) int {
+	max := uint32(n)
+	limit := ^uint32(0) - ^uint32(0)%max
+	for {
+		v := r.uint32()
+		if v < limit {
+			return int(v % max)
+		}
+	}
+}
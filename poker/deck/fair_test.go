@@ -0,0 +1,79 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestFairShuffle(t *testing.T) {
+	serverSeed := deck.NewServerSeed(deck.NewSeededShuffler(1))
+	assert.Len(t, serverSeed, 64)
+	assert.Equal(t, serverSeed, deck.NewServerSeed(deck.NewSeededShuffler(1)))
+
+	d := deck.FairShuffle(deck.New(), serverSeed, "client")
+	assert.Equal(t, deck.FullDeckSize, len(d))
+	assert.NotEqual(t, deck.New().String(), d.String())
+	// same seeds same deck
+	assert.Equal(t, d.String(), deck.FairShuffle(deck.New(), serverSeed, "client").String())
+	// the client seed changes the order
+	assert.NotEqual(t, d.String(), deck.FairShuffle(deck.New(), serverSeed, "other").String())
+	assert.NotEqual(t, d.String(), deck.FairShuffle(deck.New(), serverSeed, "").String())
+}
+
+func TestVerify(t *testing.T) {
+	serverSeed := deck.NewServerSeed(deck.CryptoShuffler{})
+	// the commitment is published before the client seed is known
+	commitment := deck.Commitment(serverSeed)
+	d := deck.FairShuffle(deck.NewShort(), serverSeed, "client")
+
+	tests := []struct {
+		Name       string
+		Unshuffled deck.Deck
+		ServerSeed string
+		ClientSeed string
+		ExpValid   bool
+		ExpDeck    bool
+	}{
+		{
+			Name:       "Valid seeds",
+			Unshuffled: deck.NewShort(),
+			ServerSeed: serverSeed,
+			ClientSeed: "client",
+			ExpValid:   true,
+			ExpDeck:    true,
+		},
+		{
+			// the seed is the one committed to, but the deck isn't the one dealt
+			Name:       "Wrong client seed",
+			Unshuffled: deck.NewShort(),
+			ServerSeed: serverSeed,
+			ClientSeed: "",
+			ExpValid:   true,
+		},
+		{
+			Name:       "Wrong server seed",
+			Unshuffled: deck.NewShort(),
+			ServerSeed: deck.NewServerSeed(deck.CryptoShuffler{}),
+			ClientSeed: "client",
+		},
+		{
+			Name:       "Wrong deck",
+			Unshuffled: deck.New(),
+			ServerSeed: serverSeed,
+			ClientSeed: "client",
+			ExpValid:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			unshuffled := tt.Unshuffled.String()
+			out, valid := deck.Verify(tt.Unshuffled, tt.ServerSeed, tt.ClientSeed, commitment)
+			assert.Equal(t, tt.ExpValid, valid)
+			// the passed deck is left untouched
+			assert.Equal(t, unshuffled, tt.Unshuffled.String())
+			assert.Equal(t, tt.ExpDeck, d.String() == out.String())
+		})
+	}
+}
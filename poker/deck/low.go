@@ -0,0 +1,143 @@
+package deck
+
+import "sort"
+
+/*
+   8 or better low hand ranking, used to split the pot in hi-lo games.
+
+   A qualifying low is 5 cards of distinct ranks, all 8 or lower (aces play low).
+   Straights and flushes do not count against a low hand.
+
+   Number of Distinct Low Hand Values:
+
+   C(8, 5) = 56
+
+   Like the high hand lookups, low hands are keyed on the unique prime product of the
+   5 ranks, which maps to a rank in range [1, 56]:
+
+   = 5-4-3-2-A = 1
+   = 8-7-6-5-4 = 56
+*/
+
+const WorstLow = uint32(56)
+
+// low values of the ranks that can make a qualifying low, ace is 1
+var LOW_VALUE_TO_PRIME = map[int]uint32{
+	1: 41, // A
+	2: 2,
+	3: 3,
+	4: 5,
+	5: 7,
+	6: 11,
+	7: 13,
+	8: 17,
+}
+
+var LOW_LOOKUP = make(map[uint32]uint32)
+
+func init() {
+	LOW_LOOKUP = low_lookup()
+}
+
+// low_lookup generates every qualifying low and ranks it,
+// comparing the highest card first, then the next highest and so on
+func low_lookup() map[uint32]uint32 {
+	hands := [][]int{}
+	for a := 8; a >= 1; a-- {
+		for b := a - 1; b >= 1; b-- {
+			for c := b - 1; c >= 1; c-- {
+				for d := c - 1; d >= 1; d-- {
+					for e := d - 1; e >= 1; e-- {
+						hands = append(hands, []int{a, b, c, d, e})
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(hands, func(i, j int) bool {
+		for k := range hands[i] {
+			if hands[i][k] != hands[j][k] {
+				return hands[i][k] < hands[j][k]
+			}
+		}
+		return false
+	})
+
+	out := make(map[uint32]uint32, len(hands))
+	for i, hand := range hands {
+		product := uint32(1)
+		for _, v := range hand {
+			product *= LOW_VALUE_TO_PRIME[v]
+		}
+		out[product] = uint32(i + 1)
+	}
+	return out
+}
+
+// LowLogic scores the best 8 or better low using any 5 of the cards.
+// Returns false if the cards don't make a qualifying low.
+func LowLogic(args []string) (uint32, bool) {
+	return evaluateLow(cardsFromStrings(args))
+}
+
+func evaluateLow(cards []Card) (uint32, bool) {
+	best_score := WorstLow + 1
+	for _, hand := range hand_permutations(cards, HANDSIZE_TO_PERMUTATION_MAP[len(cards)]) {
+		if handscore, ok := five_low(hand); ok && handscore < best_score {
+			best_score = handscore
+		}
+	}
+	if best_score > WorstLow {
+		return 0, false
+	}
+	return best_score, true
+}
+
+// OmahaLowLogic scores the best 8 or better low using exactly two of the hole cards and three of the board cards.
+// Returns false if the cards don't make a qualifying low.
+func OmahaLowLogic(hand []string, board []string) (uint32, bool) {
+	return evaluateOmahaLow(cardsFromStrings(hand), cardsFromStrings(board))
+}
+
+func evaluateOmahaLow(holeCards []Card, boardCards []Card) (uint32, bool) {
+	if len(holeCards) != OmahaHoleCards {
+		return 0, false
+	}
+
+	best_score := WorstLow + 1
+	cards := make([]Card, 5)
+	for _, h := range TWO_FROM_FOUR {
+		for _, b := range BOARDSIZE_TO_COMBINATION_MAP[len(boardCards)] {
+			cards[0] = holeCards[h[0]]
+			cards[1] = holeCards[h[1]]
+			cards[2] = boardCards[b[0]]
+			cards[3] = boardCards[b[1]]
+			cards[4] = boardCards[b[2]]
+
+			if handscore, ok := five_low(cards); ok && handscore < best_score {
+				best_score = handscore
+			}
+		}
+	}
+	if best_score > WorstLow {
+		return 0, false
+	}
+	return best_score, true
+}
+
+// EvaluateLow scores the best 8 or better low in the hand
+func (h Hand) EvaluateLow() (uint32, bool) {
+	return evaluateLow(h)
+}
+
+// EvaluateOmahaLow scores the best 8 or better low of the omaha hole cards played with the given board
+func (h Hand) EvaluateOmahaLow(board Hand) (uint32, bool) {
+	return evaluateOmahaLow(h, board)
+}
+
+// five_low looks up the low rank of exactly 5 cards.
+// paired hands or cards above an 8 have no entry in the lookup
+func five_low(cards []Card) (uint32, bool) {
+	score, ok := LOW_LOOKUP[prime_product_from_hand(cards)]
+	return score, ok
+}
@@ -0,0 +1,160 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+// Every qualifying 8 or better low, from best to worst
+var allLows = [][]string{
+	{"5h", "4d", "3c", "2s", "Ah"}, {"6h", "4d", "3c", "2s", "Ah"},
+	{"6h", "5d", "3c", "2s", "Ah"}, {"6h", "5d", "4c", "2s", "Ah"},
+	{"6h", "5d", "4c", "3s", "Ah"}, {"6h", "5d", "4c", "3s", "2h"},
+	{"7h", "4d", "3c", "2s", "Ah"}, {"7h", "5d", "3c", "2s", "Ah"},
+	{"7h", "5d", "4c", "2s", "Ah"}, {"7h", "5d", "4c", "3s", "Ah"},
+	{"7h", "5d", "4c", "3s", "2h"}, {"7h", "6d", "3c", "2s", "Ah"},
+	{"7h", "6d", "4c", "2s", "Ah"}, {"7h", "6d", "4c", "3s", "Ah"},
+	{"7h", "6d", "4c", "3s", "2h"}, {"7h", "6d", "5c", "2s", "Ah"},
+	{"7h", "6d", "5c", "3s", "Ah"}, {"7h", "6d", "5c", "3s", "2h"},
+	{"7h", "6d", "5c", "4s", "Ah"}, {"7h", "6d", "5c", "4s", "2h"},
+	{"7h", "6d", "5c", "4s", "3h"}, {"8h", "4d", "3c", "2s", "Ah"},
+	{"8h", "5d", "3c", "2s", "Ah"}, {"8h", "5d", "4c", "2s", "Ah"},
+	{"8h", "5d", "4c", "3s", "Ah"}, {"8h", "5d", "4c", "3s", "2h"},
+	{"8h", "6d", "3c", "2s", "Ah"}, {"8h", "6d", "4c", "2s", "Ah"},
+	{"8h", "6d", "4c", "3s", "Ah"}, {"8h", "6d", "4c", "3s", "2h"},
+	{"8h", "6d", "5c", "2s", "Ah"}, {"8h", "6d", "5c", "3s", "Ah"},
+	{"8h", "6d", "5c", "3s", "2h"}, {"8h", "6d", "5c", "4s", "Ah"},
+	{"8h", "6d", "5c", "4s", "2h"}, {"8h", "6d", "5c", "4s", "3h"},
+	{"8h", "7d", "3c", "2s", "Ah"}, {"8h", "7d", "4c", "2s", "Ah"},
+	{"8h", "7d", "4c", "3s", "Ah"}, {"8h", "7d", "4c", "3s", "2h"},
+	{"8h", "7d", "5c", "2s", "Ah"}, {"8h", "7d", "5c", "3s", "Ah"},
+	{"8h", "7d", "5c", "3s", "2h"}, {"8h", "7d", "5c", "4s", "Ah"},
+	{"8h", "7d", "5c", "4s", "2h"}, {"8h", "7d", "5c", "4s", "3h"},
+	{"8h", "7d", "6c", "2s", "Ah"}, {"8h", "7d", "6c", "3s", "Ah"},
+	{"8h", "7d", "6c", "3s", "2h"}, {"8h", "7d", "6c", "4s", "Ah"},
+	{"8h", "7d", "6c", "4s", "2h"}, {"8h", "7d", "6c", "4s", "3h"},
+	{"8h", "7d", "6c", "5s", "Ah"}, {"8h", "7d", "6c", "5s", "2h"},
+	{"8h", "7d", "6c", "5s", "3h"}, {"8h", "7d", "6c", "5s", "4h"},
+}
+
+func TestDeck_LowLogicRankings(t *testing.T) {
+	assert.Equal(t, int(deck.WorstLow), len(allLows))
+	for i, hand := range allLows {
+		score, ok := deck.LowLogic(hand)
+		assert.True(t, ok, hand)
+		assert.Equal(t, i+1, int(score), hand)
+	}
+}
+
+func TestDeck_LowLogic(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		hand     []string
+		ExpScore uint32
+		ExpLow   bool
+	}{
+		{
+			Name:     "The wheel is the best low even though it is a straight flush",
+			hand:     []string{"5s", "4s", "3s", "2s", "As"},
+			ExpScore: 1,
+			ExpLow:   true,
+		},
+		{
+			Name:   "A pair does not qualify",
+			hand:   []string{"5s", "5d", "3s", "2s", "As"},
+			ExpLow: false,
+		},
+		{
+			Name:   "A 9 does not qualify",
+			hand:   []string{"9s", "4d", "3s", "2s", "As"},
+			ExpLow: false,
+		},
+		{
+			Name:     "Best low from 7 cards",
+			hand:     []string{"Ks", "Kd", "8c", "7h", "4d", "3s", "2s"},
+			ExpScore: 40,
+			ExpLow:   true,
+		},
+		{
+			Name:     "Pairs on the board are skipped for the low",
+			hand:     []string{"Ah", "Ad", "2c", "2h", "3d", "6s", "4s"},
+			ExpScore: 2,
+			ExpLow:   true,
+		},
+		{
+			Name:   "No low from 7 cards",
+			hand:   []string{"Ah", "Ad", "2c", "2h", "3d", "9s", "Js"},
+			ExpLow: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			score, ok := deck.LowLogic(tt.hand)
+			assert.Equal(t, tt.ExpLow, ok)
+			assert.Equal(t, int(tt.ExpScore), int(score))
+
+			hand := deck.Hand{}
+			for _, c := range tt.hand {
+				hand = append(hand, *deck.NewCard(c))
+			}
+			score, ok = hand.EvaluateLow()
+			assert.Equal(t, tt.ExpLow, ok)
+			assert.Equal(t, int(tt.ExpScore), int(score))
+		})
+	}
+}
+
+func TestDeck_OmahaLowLogic(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		hand     []string
+		board    []string
+		ExpScore uint32
+		ExpLow   bool
+	}{
+		{
+			Name:     "Wheel using two from hand and three from board",
+			hand:     []string{"Ah", "2d", "Kc", "Ks"},
+			board:    []string{"3h", "4d", "5c", "Qh", "Jh"},
+			ExpScore: 1,
+			ExpLow:   true,
+		},
+		{
+			Name:   "Four low cards on board but only one low card in hand",
+			hand:   []string{"Ah", "Ad", "Kc", "Ks"},
+			board:  []string{"2h", "3d", "4c", "5h", "Jh"},
+			ExpLow: false,
+		},
+		{
+			Name:   "Only two low cards on board",
+			hand:   []string{"Ah", "2d", "3c", "4s"},
+			board:  []string{"5h", "6d", "Tc", "Jh", "Qh"},
+			ExpLow: false,
+		},
+		{
+			Name:   "Counterfeited hole card",
+			hand:   []string{"Ah", "2d", "Kc", "Ks"},
+			board:  []string{"2h", "7d", "8c", "Qh", "Jh"},
+			ExpLow: false,
+		},
+		{
+			Name:     "Low using the three board cards that don't pair the hand",
+			hand:     []string{"Ah", "2d", "Kc", "Ks"},
+			board:    []string{"2h", "7d", "8c", "3h", "Jh"},
+			ExpScore: 37,
+			ExpLow:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			score, ok := deck.OmahaLowLogic(tt.hand, tt.board)
+			assert.Equal(t, tt.ExpLow, ok)
+			assert.Equal(t, int(tt.ExpScore), int(score))
+		})
+	}
+}
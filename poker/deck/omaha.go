@@ -0,0 +1,66 @@
+package deck
+
+// Omaha hands must be made from exactly two hole cards and three board cards
+
+const OmahaHoleCards = 4
+
+var TWO_FROM_FOUR = [][2]uint8{
+	{0, 1}, {0, 2}, {0, 3},
+	{1, 2}, {1, 3}, {2, 3},
+}
+
+var THREE_FROM_FIVE = [][3]uint8{
+	{0, 1, 2}, {0, 1, 3}, {0, 1, 4},
+	{0, 2, 3}, {0, 2, 4}, {0, 3, 4},
+	{1, 2, 3}, {1, 2, 4}, {1, 3, 4},
+	{2, 3, 4},
+}
+
+var THREE_FROM_FOUR = [][3]uint8{
+	{0, 1, 2}, {0, 1, 3}, {0, 2, 3}, {1, 2, 3},
+}
+
+var THREE_FROM_THREE = [][3]uint8{
+	{0, 1, 2},
+}
+
+var BOARDSIZE_TO_COMBINATION_MAP = map[int][][3]uint8{
+	3: THREE_FROM_THREE,
+	4: THREE_FROM_FOUR,
+	5: THREE_FROM_FIVE,
+}
+
+// OmahaLogic scores the best hand using exactly two of the hole cards and three of the board cards.
+// Returns the worst possible score (7462) if the hand or board is too small to make a hand.
+func OmahaLogic(hand []string, board []string) uint32 {
+	return evaluateOmaha(cardsFromStrings(hand), cardsFromStrings(board))
+}
+
+func evaluateOmaha(holeCards []Card, boardCards []Card) uint32 {
+	best_score := uint32(7462)
+	if len(holeCards) != OmahaHoleCards {
+		return best_score
+	}
+
+	cards := make([]Card, 5)
+	for _, h := range TWO_FROM_FOUR {
+		for _, b := range BOARDSIZE_TO_COMBINATION_MAP[len(boardCards)] {
+			cards[0] = holeCards[h[0]]
+			cards[1] = holeCards[h[1]]
+			cards[2] = boardCards[b[0]]
+			cards[3] = boardCards[b[1]]
+			cards[4] = boardCards[b[2]]
+
+			handscore := five(cards)
+			if handscore < best_score {
+				best_score = handscore
+			}
+		}
+	}
+	return best_score
+}
+
+// EvaluateOmaha scores the hand as omaha hole cards played with the given board
+func (h Hand) EvaluateOmaha(board Hand) uint32 {
+	return evaluateOmaha(h, board)
+}
@@ -0,0 +1,65 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestDeck_OmahaLogic(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		hand     []string
+		board    []string
+		ExpScore uint32
+	}{
+		{
+			Name:     "Royal flush using two from hand and three from board",
+			hand:     []string{"As", "Ks", "2d", "3c"},
+			board:    []string{"Qs", "Js", "Ts", "4h", "5h"},
+			ExpScore: 1,
+		},
+		{
+			Name:     "Four aces in hand only play as two pair",
+			hand:     []string{"Ah", "Ad", "Ac", "As"},
+			board:    []string{"Kh", "Kd", "2c", "3s", "4d"},
+			ExpScore: deck.Logic([]string{"Ah", "Ad", "Kh", "Kd", "4d"}),
+		},
+		{
+			Name:     "Flush on board with one suited hole card is not a flush",
+			hand:     []string{"Ah", "Kd", "2c", "3c"},
+			board:    []string{"5h", "6h", "7h", "8h", "Jh"},
+			ExpScore: deck.Logic([]string{"Ah", "Kd", "Jh", "8h", "7h"}),
+		},
+		{
+			Name:     "Flop only",
+			hand:     []string{"Ah", "Ad", "7c", "2s"},
+			board:    []string{"As", "7d", "7h"},
+			ExpScore: deck.Logic([]string{"Ah", "Ad", "As", "7d", "7h"}),
+		},
+		{
+			Name:     "No board",
+			hand:     []string{"Ah", "Ad", "7c", "2s"},
+			board:    []string{},
+			ExpScore: 7462,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			score := deck.OmahaLogic(tt.hand, tt.board)
+			assert.Equal(t, int(tt.ExpScore), int(score))
+
+			hand := deck.Hand{}
+			for _, c := range tt.hand {
+				hand = append(hand, *deck.NewCard(c))
+			}
+			board := deck.Hand{}
+			for _, c := range tt.board {
+				board = append(board, *deck.NewCard(c))
+			}
+			assert.Equal(t, int(tt.ExpScore), int(hand.EvaluateOmaha(board)))
+		})
+	}
+}
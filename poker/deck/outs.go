@@ -0,0 +1,211 @@
+package deck
+
+import (
+	"errors"
+	"math/bits"
+)
+
+var ErrOutsBoard = errors.New("outs are calculated on a flop or turn board")
+
+// Draw is a kind of drawing hand
+type Draw int
+
+const (
+	NoDraw Draw = iota
+	// 4 to a flush
+	FlushDraw
+	// 2 ranks complete a straight, this includes double gutshots
+	OpenEndedStraightDraw
+	// 1 rank completes a straight
+	Gutshot
+	// 3 to a flush on the flop, needing both the turn and river
+	BackdoorFlushDraw
+	// a straight needing both the turn and river
+	BackdoorStraightDraw
+)
+
+var drawNames = [...]string{
+	NoDraw:                "No draw",
+	FlushDraw:             "Flush draw",
+	OpenEndedStraightDraw: "Open ended straight draw",
+	Gutshot:               "Gutshot",
+	BackdoorFlushDraw:     "Backdoor flush draw",
+	BackdoorStraightDraw:  "Backdoor straight draw",
+}
+
+func (d Draw) String() string {
+	return drawNames[d]
+}
+
+// HandOuts is a hold'em hand's outs against the other hands on the flop or turn
+type HandOuts struct {
+	// the hand is the best or tied for the best now, so it has no outs
+	Leading bool
+	// unseen cards that make the hand the best or tied for the best on the next card
+	Outs Hand
+	// draws the hole cards make with the board, whatever the other hands hold
+	Draws []Draw
+	// percentage chance of an out coming by the river
+	Probability float64
+}
+
+// Outs works out each hand's outs against the others on a flop or turn board.
+// Every hand is known, so the unseen cards are the deck less the board and all the hands.
+func Outs(hands []Hand, board Hand) ([]HandOuts, error) {
+	if len(hands) < 2 {
+		return nil, ErrEquityHands
+	}
+	if len(board) != 3 && len(board) != 4 {
+		return nil, ErrOutsBoard
+	}
+
+	used := map[Card]bool{}
+	known := append(Hand{}, board...)
+	for _, h := range hands {
+		if len(h) != 2 {
+			return nil, ErrEquityHands
+		}
+		known = append(known, h...)
+	}
+	for _, c := range known {
+		if used[c] {
+			return nil, ErrDuplicateCard
+		}
+		used[c] = true
+	}
+	unseen := Hand{}
+	for _, c := range New() {
+		if !used[c] {
+			unseen = append(unseen, c)
+		}
+	}
+
+	current := bestHands(hands, board)
+	out := make([]HandOuts, len(hands))
+	for i, h := range hands {
+		out[i].Draws = draws(h, board)
+		out[i].Leading = current[i]
+	}
+
+	next := append(append(Hand{}, board...), 0)
+	for _, c := range unseen {
+		next[len(board)] = c
+		for i, best := range bestHands(hands, next) {
+			if best && !out[i].Leading {
+				out[i].Outs = append(out[i].Outs, c)
+			}
+		}
+	}
+
+	for i := range out {
+		outs := len(out[i].Outs)
+		if len(board) == 3 {
+			// 1 minus the chance of missing on both the turn and the river
+			miss := float64(binomial(len(unseen)-outs, 2)) / float64(binomial(len(unseen), 2))
+			out[i].Probability = 100 * (1 - miss)
+		} else {
+			out[i].Probability = 100 * float64(outs) / float64(len(unseen))
+		}
+	}
+	return out, nil
+}
+
+// bestHands returns which hands are the best or tied for the best with the board
+func bestHands(hands []Hand, board Hand) []bool {
+	scores := make([]uint32, len(hands))
+	best := uint32(7462 + 1)
+	for i, h := range hands {
+		scores[i] = append(append(Hand{}, h...), board...).EvaluateHand()
+		if scores[i] < best {
+			best = scores[i]
+		}
+	}
+	out := make([]bool, len(hands))
+	for i, s := range scores {
+		out[i] = s == best
+	}
+	return out
+}
+
+// draws classifies the flush and straight draws the hole cards make with the board.
+// Draws the board makes on its own aren't counted, and a made hand isn't a draw.
+func draws(hand Hand, board Hand) []Draw {
+	out := []Draw{}
+	cards := append(append(Hand{}, hand...), board...)
+
+	suits := map[uint32]int{}
+	for _, c := range cards {
+		suits[uint32(c)>>12&0xF]++
+	}
+	flush := false
+	for _, n := range suits {
+		flush = flush || n >= 5
+	}
+	// the hole cards have to be in the suit drawn to
+	suitDraw := func(n int) bool {
+		for _, h := range hand {
+			if suits[uint32(h)>>12&0xF] == n {
+				return true
+			}
+		}
+		return false
+	}
+	if !flush && suitDraw(4) {
+		out = append(out, FlushDraw)
+	} else if !flush && len(board) == 3 && suitDraw(3) {
+		out = append(out, BackdoorFlushDraw)
+	}
+
+	mask, boardMask := rankMask(cards), rankMask(board)
+	if isStraight(mask) {
+		return out
+	}
+	// straights made with the hole cards that the board alone doesn't make
+	completes := func(add uint16) bool {
+		return isStraight(mask|add) && !isStraight(boardMask|add)
+	}
+	ranks := 0
+	for r := uint(0); r < 13; r++ {
+		if mask&(1<<r) == 0 && completes(1<<r) {
+			ranks++
+		}
+	}
+	switch {
+	case ranks >= 2:
+		out = append(out, OpenEndedStraightDraw)
+	case ranks == 1:
+		out = append(out, Gutshot)
+	case len(board) == 3:
+		for r1 := uint(0); r1 < 13; r1++ {
+			for r2 := r1 + 1; r2 < 13; r2++ {
+				add := uint16(1)<<r1 | uint16(1)<<r2
+				if mask&add == 0 && completes(add) {
+					return append(out, BackdoorStraightDraw)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// rankMask has a bit set for each rank in the cards, deuce=bit 0 through ace=bit 12
+func rankMask(cards Hand) uint16 {
+	mask := uint16(0)
+	for _, c := range cards {
+		mask |= 1 << c.Rank()
+	}
+	return mask
+}
+
+// isStraight returns true if the ranks have 5 in a row, aces play high or low
+func isStraight(mask uint16) bool {
+	// shift up to make room for the low ace below the deuce
+	m := uint32(mask)<<1 | uint32(mask)>>12&1
+	for ; m != 0; m &= m - 1 {
+		low := bits.TrailingZeros32(m)
+		if m>>low&0x1F == 0x1F {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestOuts(t *testing.T) {
+
+	tests := []struct {
+		Name           string
+		Hands          []string
+		Board          string
+		ExpLeading     []bool
+		ExpOuts        []int
+		ExpProbability []float64
+		ExpError       error
+	}{
+		{
+			Name:       "Flush and overcards on the flop",
+			Hands:      []string{"AhKh", "QsQd"},
+			Board:      "2h7h9c",
+			ExpLeading: []bool{false, true},
+			ExpOuts:    []int{15, 0},
+			// 45 unseen cards, 1 minus C(30, 2) / C(45, 2) to hit by the river
+			ExpProbability: []float64{100 * (1 - 435.0/990), 0},
+		},
+		{
+			Name:           "Flush and overcards on the turn",
+			Hands:          []string{"AhKh", "QsQd"},
+			Board:          "2h7h9cTs",
+			ExpLeading:     []bool{false, true},
+			ExpOuts:        []int{15, 0},
+			ExpProbability: []float64{100 * 15.0 / 44, 0},
+		},
+		{
+			Name:           "Tied hands both lead",
+			Hands:          []string{"AhKd", "AcKs"},
+			Board:          "2h7h9c",
+			ExpLeading:     []bool{true, true},
+			ExpOuts:        []int{0, 0},
+			ExpProbability: []float64{0, 0},
+		},
+		{
+			Name:     "River board",
+			Hands:    []string{"AhKh", "QsQd"},
+			Board:    "2h7h9cTs3s",
+			ExpError: deck.ErrOutsBoard,
+		},
+		{
+			Name:     "Duplicate cards",
+			Hands:    []string{"AhKh", "QsQd"},
+			Board:    "2h7hQs",
+			ExpError: deck.ErrDuplicateCard,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			hands := []deck.Hand{}
+			for _, h := range tt.Hands {
+				hands = append(hands, deck.NewHand(h))
+			}
+			out, err := deck.Outs(hands, deck.NewHand(tt.Board))
+			if tt.ExpError != nil {
+				assert.Equal(t, tt.ExpError, err)
+				return
+			}
+			assert.NoError(t, err)
+			for i, o := range out {
+				assert.Equal(t, tt.ExpLeading[i], o.Leading)
+				assert.Equal(t, tt.ExpOuts[i], len(o.Outs))
+				assert.InDelta(t, tt.ExpProbability[i], o.Probability, 1e-9)
+			}
+		})
+	}
+}
+
+func TestOuts_Draws(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		Hand     string
+		Board    string
+		ExpDraws []deck.Draw
+	}{
+		{Name: "Flush draw", Hand: "AhKh", Board: "2h7h9c", ExpDraws: []deck.Draw{deck.FlushDraw}},
+		{Name: "Open ended", Hand: "8c9d", Board: "6s7hKd", ExpDraws: []deck.Draw{deck.OpenEndedStraightDraw}},
+		{Name: "Double gutshot", Hand: "8c9d", Board: "5s7hJd", ExpDraws: []deck.Draw{deck.OpenEndedStraightDraw}},
+		{Name: "Gutshot", Hand: "8c9d", Board: "5s7hKd", ExpDraws: []deck.Draw{deck.Gutshot}},
+		{Name: "Wheel gutshot", Hand: "As2d", Board: "3s4hKd", ExpDraws: []deck.Draw{deck.Gutshot}},
+		{Name: "Combo draw", Hand: "8h9h", Board: "6h7h2c", ExpDraws: []deck.Draw{deck.FlushDraw, deck.OpenEndedStraightDraw}},
+		{Name: "Backdoor flush", Hand: "AhKh", Board: "2h7c9s", ExpDraws: []deck.Draw{deck.BackdoorFlushDraw}},
+		{Name: "Backdoor straight", Hand: "8c9d", Board: "7hKd2s", ExpDraws: []deck.Draw{deck.BackdoorStraightDraw}},
+		{Name: "No backdoors on the turn", Hand: "8c9d", Board: "7hKd2s2c", ExpDraws: []deck.Draw{}},
+		{Name: "Made straight", Hand: "8c9d", Board: "5s6s7h", ExpDraws: []deck.Draw{}},
+		{Name: "Made flush", Hand: "AhKh", Board: "2h7h9h", ExpDraws: []deck.Draw{}},
+		{Name: "Board draws don't count", Hand: "2c2d", Board: "5h6s7d8c", ExpDraws: []deck.Draw{}},
+		{Name: "Board flush draw doesn't count", Hand: "AcKd", Board: "2h7h9hTh", ExpDraws: []deck.Draw{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			// the opponent holds cards that don't affect the draws
+			hands := []deck.Hand{deck.NewHand(tt.Hand), deck.NewHand("QsQd")}
+			out, err := deck.Outs(hands, deck.NewHand(tt.Board))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpDraws, out[0].Draws)
+		})
+	}
+}
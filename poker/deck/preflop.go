@@ -0,0 +1,227 @@
+package deck
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+/*
+	Preflop equity of the 169 starting hand classes against random hands.
+
+	Every combo of a class has the same equity against random hands, so one combo is
+	sampled per class. The table is generated by run_preflop into preflop_table.go as
+	CSV, one row per class with the equity against 1 to MaxPreflopOpponents opponents,
+	and parsed when the package is loaded.
+*/
+
+// MaxPreflopOpponents is the most opponents in the preflop table, a full 10 handed table
+const MaxPreflopOpponents = 9
+
+var ErrPreflopOpponents = fmt.Errorf("preflop equity is for 1 to %d opponents", MaxPreflopOpponents)
+var ErrPreflopTable = errors.New("invalid preflop table")
+
+// PreflopTable is the equity percentage of each starting hand class,
+// indexed by the number of opponents - 1
+type PreflopTable map[string][MaxPreflopOpponents]float64
+
+var preflopTable = mustParsePreflopTable(preflopTableCSV)
+
+// PreflopClasses returns the 169 starting hand classes in the order of the usual 13x13 grid.
+// Rows and columns go from aces down to deuces, with pairs on the diagonal,
+// suited hands above it and offsuit hands below it.
+func PreflopClasses() []string {
+	out := make([]string, 0, 169)
+	for row := 12; row >= 0; row-- {
+		for col := 12; col >= 0; col-- {
+			switch {
+			case row == col:
+				out = append(out, string([]byte{rankChars[row], rankChars[col]}))
+			case col < row:
+				out = append(out, string([]byte{rankChars[row], rankChars[col], 's'}))
+			default:
+				out = append(out, string([]byte{rankChars[col], rankChars[row], 'o'}))
+			}
+		}
+	}
+	return out
+}
+
+// PreflopClass returns the starting hand class of 2 hole cards, "AA", "AKs" or "AKo"
+func PreflopClass(h Hand) (string, error) {
+	if len(h) != 2 || h[0] == h[1] {
+		return "", ErrEquityHands
+	}
+	hi, lo := h[0], h[1]
+	if hi.Rank() < lo.Rank() {
+		hi, lo = lo, hi
+	}
+	class := []byte{rankChars[hi.Rank()], rankChars[lo.Rank()]}
+	if hi.Rank() == lo.Rank() {
+		return string(class), nil
+	}
+	if hi.suitChar() == lo.suitChar() {
+		return string(append(class, 's')), nil
+	}
+	return string(append(class, 'o')), nil
+}
+
+// PreflopEquity looks up the equity of the hole cards against random hands
+func PreflopEquity(h Hand, opponents int) (float64, error) {
+	class, err := PreflopClass(h)
+	if err != nil {
+		return 0, err
+	}
+	return PreflopClassEquity(class, opponents)
+}
+
+// PreflopClassEquity looks up the equity of a starting hand class such as "AKs" against random hands
+func PreflopClassEquity(class string, opponents int) (float64, error) {
+	if opponents < 1 || opponents > MaxPreflopOpponents {
+		return 0, ErrPreflopOpponents
+	}
+	equity, ok := preflopTable[class]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRange, class)
+	}
+	return equity[opponents-1], nil
+}
+
+// GeneratePreflopTable samples the equity of every starting hand class against 1 to
+// MaxPreflopOpponents random hands, opts.Iterations deals for each
+func GeneratePreflopTable(opts EquityOptions) PreflopTable {
+	if opts.Iterations <= 0 {
+		opts.Iterations = DefaultEquityIterations
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+
+	out := PreflopTable{}
+	for i, class := range PreflopClasses() {
+		hand := preflopCombo(class)
+		var equity [MaxPreflopOpponents]float64
+		for opponents := 1; opponents <= MaxPreflopOpponents; opponents++ {
+			// every class and number of opponents gets its own seeds
+			seed := opts.Seed + int64(i*MaxPreflopOpponents+opponents-1)<<32
+			equity[opponents-1] = randomOpponentEquity(hand, opponents, opts.Iterations, seed, opts.Workers)
+		}
+		out[class] = equity
+	}
+	return out
+}
+
+// preflopCombo returns a combo of the class, spades and hearts
+func preflopCombo(class string) Hand {
+	hi := uint32(strings.IndexByte(rankChars, class[0]))
+	lo := uint32(strings.IndexByte(rankChars, class[1]))
+	suit := STRING_INT_TO_SUIT['h']
+	if strings.HasSuffix(class, "s") {
+		suit = STRING_INT_TO_SUIT['s']
+	}
+	return Hand{newCard(hi, STRING_INT_TO_SUIT['s']), newCard(lo, suit)}
+}
+
+// randomOpponentEquity samples the equity of the hand against random hands
+func randomOpponentEquity(hand Hand, opponents, iterations int, seed int64, workers int) float64 {
+	remaining := Deck{}
+	for _, c := range New() {
+		if c != hand[0] && c != hand[1] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	chunks := (iterations + equityChunkSize - 1) / equityChunkSize
+	total := runEquityChunks(chunks, workers, opponents+1, func(chunk int, t *equityTally) {
+		n := equityChunkSize
+		if chunk == chunks-1 {
+			n = iterations - chunk*equityChunkSize
+		}
+		r := rand.New(rand.NewSource(seed + int64(chunk)))
+		cards := append(Deck{}, remaining...)
+		e := &equityRun{hands: make([]Hand, opponents+1)}
+		e.hands[0] = hand
+		for ; n > 0; n-- {
+			// partial fisher-yates, only the opponents' cards and the board are shuffled
+			dealt := 2*opponents + 5
+			for i := 0; i < dealt; i++ {
+				j := i + r.Intn(len(cards)-i)
+				cards[i], cards[j] = cards[j], cards[i]
+			}
+			for o := 1; o <= opponents; o++ {
+				e.hands[o] = Hand(cards[2*o-2 : 2*o])
+			}
+			copy(t.board[:], cards[2*opponents:dealt])
+			e.score(t)
+		}
+	})
+	return total.result(false).Hands[0].Equity
+}
+
+// WritePreflopTable writes the table as CSV, a header then one row per class in grid order
+func WritePreflopTable(w io.Writer, table PreflopTable) error {
+	out := csv.NewWriter(w)
+	header := []string{"class"}
+	for opponents := 1; opponents <= MaxPreflopOpponents; opponents++ {
+		header = append(header, strconv.Itoa(opponents))
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	for _, class := range PreflopClasses() {
+		row := []string{class}
+		for _, e := range table[class] {
+			row = append(row, strconv.FormatFloat(e, 'f', 2, 64))
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// ParsePreflopTable reads a table written by WritePreflopTable
+func ParsePreflopTable(r io.Reader) (PreflopTable, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPreflopTable, err)
+	}
+	if len(rows) != 170 {
+		return nil, fmt.Errorf("%w: %d rows", ErrPreflopTable, len(rows))
+	}
+
+	out := PreflopTable{}
+	for _, row := range rows[1:] {
+		if len(row) != MaxPreflopOpponents+1 {
+			return nil, fmt.Errorf("%w: %q", ErrPreflopTable, row)
+		}
+		var equity [MaxPreflopOpponents]float64
+		for i, s := range row[1:] {
+			equity[i], err = strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrPreflopTable, err)
+			}
+		}
+		out[row[0]] = equity
+	}
+	for _, class := range PreflopClasses() {
+		if _, ok := out[class]; !ok {
+			return nil, fmt.Errorf("%w: missing %s", ErrPreflopTable, class)
+		}
+	}
+	return out, nil
+}
+
+func mustParsePreflopTable(s string) PreflopTable {
+	table, err := ParsePreflopTable(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
@@ -0,0 +1,176 @@
+// Code generated by run_preflop; DO NOT EDIT.
+
+package deck
+
+// preflop equity percentages against 1 to 9 random hands, 1000000 deals each, seed 1
+const preflopTableCSV = `class,1,2,3,4,5,6,7,8,9
+AA,85.15,73.44,63.79,55.86,49.15,43.53,38.79,34.66,31.03
+AKs,67.05,50.72,41.42,35.36,31.03,27.73,24.86,22.70,20.72
+AQs,66.23,49.38,39.84,33.64,29.28,25.96,23.27,21.11,19.24
+AJs,65.39,48.16,38.45,32.25,27.92,24.58,22.04,19.92,18.20
+ATs,64.54,47.04,37.13,31.02,26.78,23.48,21.01,19.03,17.34
+A9s,62.73,44.55,34.44,28.33,24.18,21.04,18.76,16.95,15.36
+A8s,61.92,43.56,33.39,27.37,23.26,20.21,18.00,16.22,14.76
+A7s,60.97,42.44,32.38,26.44,22.41,19.50,17.33,15.66,14.27
+A6s,59.93,41.29,31.24,25.46,21.62,18.87,16.80,15.23,13.92
+A5s,59.95,41.46,31.65,25.98,22.21,19.53,17.38,15.82,14.44
+A4s,58.99,40.49,30.91,25.40,21.70,19.05,17.08,15.54,14.19
+A3s,58.25,39.72,30.15,24.78,21.18,18.65,16.67,15.23,13.93
+A2s,57.41,38.78,29.36,24.07,20.61,18.14,16.21,14.82,13.55
+AKo,65.35,48.20,38.50,32.34,27.93,24.40,21.55,19.28,17.18
+KK,82.41,68.87,58.29,49.75,42.99,37.37,32.87,29.18,26.07
+KQs,63.47,47.10,38.19,32.46,28.35,25.13,22.52,20.39,18.59
+KJs,62.54,45.94,36.84,31.03,26.97,23.86,21.32,19.30,17.65
+KTs,61.78,44.83,35.72,29.88,25.85,22.84,20.37,18.46,16.91
+K9s,60.03,42.30,32.96,27.17,23.25,20.44,18.01,16.28,14.86
+K8s,58.34,40.12,30.81,25.12,21.41,18.67,16.53,14.91,13.59
+K7s,57.58,39.20,29.98,24.34,20.70,18.00,15.96,14.39,13.10
+K6s,56.61,38.26,29.09,23.58,20.07,17.44,15.50,14.00,12.77
+K5s,55.76,37.35,28.34,22.89,19.48,16.94,15.19,13.70,12.49
+K4s,54.83,36.55,27.58,22.34,19.03,16.60,14.86,13.50,12.27
+K3s,54.03,35.75,26.96,21.78,18.62,16.28,14.59,13.23,12.12
+K2s,53.16,34.94,26.23,21.31,18.22,15.94,14.30,13.07,11.94
+AQo,64.51,46.79,36.90,30.47,25.94,22.47,19.71,17.38,15.56
+KQo,61.50,44.36,35.13,29.30,25.06,21.71,19.05,16.91,15.07
+QQ,79.92,64.85,53.48,44.79,37.90,32.57,28.34,24.92,22.21
+QJs,60.22,44.20,35.63,30.26,26.29,23.21,20.78,18.83,17.15
+QTs,59.49,43.17,34.53,29.04,25.17,22.23,19.83,18.03,16.54
+Q9s,57.72,40.64,31.88,26.40,22.58,19.79,17.61,15.94,14.53
+Q8s,56.03,38.53,29.76,24.37,20.73,18.00,16.02,14.41,13.17
+Q7s,54.33,36.47,27.70,22.55,19.08,16.56,14.77,13.18,12.06
+Q6s,53.61,35.71,27.03,21.97,18.50,16.09,14.30,12.86,11.71
+Q5s,52.79,34.88,26.30,21.30,18.03,15.71,14.00,12.57,11.53
+Q4s,51.83,33.98,25.55,20.72,17.61,15.33,13.67,12.32,11.33
+Q3s,50.96,33.18,24.91,20.19,17.12,14.96,13.39,12.10,11.15
+Q2s,50.21,32.40,24.19,19.71,16.77,14.68,13.18,11.93,10.96
+AJo,63.54,45.50,35.29,28.83,24.29,20.97,18.25,16.09,14.26
+KJo,60.53,43.08,33.67,27.70,23.50,20.37,17.74,15.69,13.96
+QJo,58.19,41.40,32.58,26.88,22.85,19.78,17.30,15.34,13.68
+JJ,77.48,61.14,49.14,40.29,33.52,28.53,24.55,21.68,19.31
+JTs,57.58,41.98,33.83,28.71,24.84,22.04,19.77,18.04,16.58
+J9s,55.63,39.48,31.24,26.08,22.32,19.65,17.51,15.89,14.64
+J8s,53.99,37.41,29.09,24.07,20.41,17.93,15.97,14.45,13.29
+J7s,52.27,35.40,27.11,22.16,18.75,16.42,14.63,13.16,12.07
+J6s,50.54,33.33,25.22,20.45,17.27,15.07,13.45,12.05,11.02
+J5s,49.96,32.79,24.64,19.99,16.85,14.75,13.09,11.80,10.79
+J4s,49.13,31.92,23.99,19.41,16.38,14.38,12.79,11.60,10.58
+J3s,48.30,31.16,23.39,18.89,16.01,14.04,12.56,11.41,10.39
+J2s,47.39,30.35,22.74,18.41,15.68,13.68,12.34,11.22,10.25
+ATo,62.78,44.27,33.99,27.53,23.01,19.67,17.18,14.99,13.27
+KTo,59.72,41.86,32.47,26.44,22.27,19.08,16.73,14.62,13.07
+QTo,57.28,40.09,31.37,25.68,21.72,18.64,16.34,14.36,12.90
+JTo,55.27,39.03,30.70,25.31,21.53,18.54,16.31,14.54,13.15
+TT,75.06,57.62,45.26,36.27,29.88,25.17,21.77,19.06,17.16
+T9s,54.06,38.75,31.06,25.83,22.36,19.64,17.73,16.07,14.86
+T8s,52.39,36.70,28.95,23.86,20.55,18.02,16.25,14.70,13.57
+T7s,50.58,34.63,26.93,22.08,18.87,16.53,14.80,13.41,12.38
+T6s,48.91,32.72,25.04,20.43,17.34,15.09,13.50,12.20,11.20
+T5s,47.18,30.80,23.30,18.88,15.94,13.83,12.40,11.17,10.26
+T4s,46.45,30.21,22.77,18.36,15.58,13.57,12.15,10.96,10.04
+T3s,45.65,29.42,22.12,17.94,15.17,13.27,11.94,10.77,9.91
+T2s,44.78,28.65,21.57,17.46,14.86,12.97,11.66,10.57,9.76
+A9o,60.81,41.59,31.07,24.57,20.31,17.07,14.63,12.69,11.18
+K9o,57.87,39.24,29.40,23.51,19.53,16.46,14.12,12.33,10.83
+Q9o,55.45,37.56,28.38,22.71,18.89,16.06,13.81,12.12,10.68
+J9o,53.31,36.33,27.83,22.43,18.74,16.02,13.82,12.18,10.88
+T9o,51.54,35.62,27.70,22.44,18.87,16.18,14.07,12.57,11.34
+99,72.14,53.63,41.14,32.60,26.67,22.42,19.42,17.24,15.58
+98s,50.89,35.97,28.45,23.65,20.30,17.74,16.00,14.50,13.38
+97s,49.19,34.05,26.70,21.99,18.87,16.43,14.91,13.60,12.48
+96s,47.50,32.13,24.83,20.37,17.37,15.19,13.64,12.44,11.41
+95s,45.77,30.14,23.05,18.77,15.97,13.88,12.46,11.31,10.38
+94s,43.87,28.36,21.40,17.26,14.67,12.73,11.38,10.30,9.44
+93s,43.31,27.85,20.92,16.92,14.30,12.39,11.12,10.12,9.23
+92s,42.46,27.04,20.27,16.44,13.93,12.16,10.89,9.95,9.13
+A8o,59.89,40.43,29.91,23.50,19.24,16.18,13.82,11.96,10.51
+K8o,56.14,36.95,27.13,21.25,17.39,14.65,12.50,10.78,9.40
+Q8o,53.56,35.24,26.14,20.67,16.85,14.12,12.09,10.49,9.24
+J8o,51.45,34.11,25.61,20.27,16.73,14.14,12.18,10.61,9.43
+T8o,49.70,33.44,25.42,20.36,16.88,14.42,12.51,11.05,9.96
+98o,48.01,32.70,25.03,20.08,16.63,14.16,12.31,10.90,9.79
+88,69.19,50.02,37.57,29.53,24.08,20.34,17.74,15.85,14.48
+87s,47.87,33.93,26.67,22.07,18.99,16.73,15.02,13.79,12.72
+86s,46.19,32.07,25.01,20.53,17.72,15.61,14.03,12.84,11.88
+85s,44.54,30.12,23.24,18.99,16.24,14.36,12.91,11.77,10.87
+84s,42.68,28.22,21.55,17.47,14.89,13.06,11.74,10.66,9.85
+83s,40.86,26.35,19.87,16.08,13.60,11.95,10.72,9.74,8.95
+82s,40.18,25.85,19.40,15.71,13.31,11.71,10.49,9.53,8.75
+A7o,58.81,39.20,28.75,22.55,18.34,15.31,13.07,11.33,9.97
+K7o,55.21,35.94,26.24,20.45,16.60,13.82,11.78,10.19,8.91
+Q7o,51.75,33.05,23.97,18.61,15.03,12.51,10.59,9.18,8.03
+J7o,49.63,31.86,23.42,18.36,14.89,12.39,10.64,9.24,8.13
+T7o,47.92,31.27,23.29,18.37,15.02,12.66,11.00,9.65,8.58
+97o,46.27,30.71,23.05,18.34,15.05,12.75,11.14,9.86,8.87
+87o,45.04,30.43,23.10,18.38,15.29,13.05,11.41,10.20,9.25
+77,66.25,46.48,34.45,26.82,21.84,18.63,16.35,14.84,13.65
+76s,45.40,31.93,25.05,20.80,17.92,15.90,14.43,13.19,12.25
+75s,43.70,30.16,23.42,19.41,16.77,14.89,13.46,12.39,11.43
+74s,41.79,28.36,21.75,17.95,15.33,13.59,12.29,11.31,10.44
+73s,40.07,26.45,20.08,16.44,13.98,12.33,11.14,10.17,9.37
+72s,38.18,24.64,18.43,15.05,12.84,11.26,10.15,9.26,8.51
+A6o,57.74,37.83,27.56,21.36,17.47,14.61,12.49,10.83,9.57
+K6o,54.29,34.90,25.24,19.58,15.86,13.26,11.31,9.73,8.62
+Q6o,51.09,32.22,23.20,17.88,14.40,11.98,10.17,8.79,7.65
+J6o,47.93,29.77,21.35,16.50,13.24,11.00,9.34,8.03,7.04
+T6o,46.15,29.12,21.22,16.51,13.32,11.17,9.57,8.32,7.39
+96o,44.46,28.60,21.13,16.56,13.42,11.37,9.82,8.59,7.71
+86o,43.22,28.39,21.23,16.79,13.89,11.76,10.31,9.20,8.31
+76o,42.35,28.38,21.36,17.05,14.14,12.17,10.72,9.59,8.82
+66,63.25,43.22,31.55,24.55,20.08,17.27,15.37,13.96,13.06
+65s,43.11,30.28,23.69,19.79,17.01,15.23,13.84,12.77,11.91
+64s,41.32,28.44,22.18,18.40,15.83,14.17,12.88,11.87,11.08
+63s,39.54,26.66,20.50,16.94,14.55,12.98,11.80,10.80,10.05
+62s,37.64,24.78,18.87,15.46,13.23,11.78,10.64,9.77,9.02
+A5o,57.69,38.19,28.06,22.00,18.02,15.23,13.07,11.46,10.10
+K5o,53.22,33.95,24.40,18.86,15.28,12.78,10.83,9.41,8.27
+Q5o,50.01,31.34,22.33,17.19,13.90,11.53,9.80,8.45,7.39
+J5o,47.13,29.10,20.74,15.88,12.73,10.54,8.96,7.76,6.76
+T5o,44.23,27.07,19.33,14.83,11.84,9.81,8.38,7.26,6.37
+95o,42.64,26.51,19.14,14.76,11.91,9.94,8.51,7.47,6.55
+85o,41.44,26.38,19.38,15.17,12.32,10.42,9.09,8.10,7.23
+75o,40.52,26.51,19.70,15.52,12.87,11.01,9.72,8.68,7.89
+65o,39.91,26.76,20.00,15.82,13.28,11.47,10.20,9.22,8.45
+55,60.25,40.08,28.92,22.40,18.53,16.07,14.43,13.24,12.35
+54s,41.33,29.01,22.74,18.85,16.46,14.78,13.55,12.54,11.67
+53s,39.67,27.24,21.17,17.58,15.30,13.77,12.60,11.62,10.88
+52s,37.81,25.44,19.54,16.18,14.02,12.57,11.46,10.64,9.90
+A4o,56.69,37.17,27.15,21.25,17.40,14.77,12.74,11.14,9.88
+K4o,52.23,32.91,23.58,18.21,14.73,12.37,10.56,9.14,8.06
+Q4o,49.07,30.37,21.63,16.62,13.33,11.09,9.43,8.17,7.19
+J4o,46.09,28.20,20.02,15.37,12.27,10.19,8.62,7.43,6.60
+T4o,43.43,26.33,18.76,14.37,11.49,9.47,8.04,6.95,6.13
+94o,40.59,24.45,17.30,13.13,10.46,8.67,7.39,6.32,5.61
+84o,39.44,24.37,17.49,13.41,10.83,9.09,7.77,6.82,6.09
+74o,38.43,24.48,17.80,13.89,11.37,9.67,8.43,7.54,6.82
+64o,37.90,24.66,18.36,14.40,11.97,10.30,9.17,8.27,7.57
+54o,38.05,25.36,18.90,15.03,12.63,11.00,9.80,8.90,8.23
+44,56.93,36.80,26.31,20.54,17.25,15.30,13.86,12.89,12.13
+43s,38.57,26.46,20.41,16.91,14.78,13.20,12.07,11.13,10.36
+42s,36.77,24.70,18.84,15.67,13.62,12.17,11.18,10.28,9.60
+A3o,55.76,36.23,26.36,20.60,16.94,14.33,12.35,10.81,9.61
+K3o,51.36,32.01,22.89,17.66,14.28,11.98,10.20,8.92,7.80
+Q3o,48.17,29.43,20.87,16.02,12.93,10.74,9.10,7.92,7.00
+J3o,45.17,27.38,19.26,14.71,11.82,9.86,8.39,7.23,6.37
+T3o,42.56,25.54,18.01,13.81,11.05,9.20,7.82,6.74,5.94
+93o,40.01,23.89,16.75,12.72,10.14,8.39,7.11,6.10,5.37
+83o,37.48,22.33,15.69,11.89,9.52,7.87,6.67,5.82,5.13
+73o,36.59,22.46,16.00,12.28,9.96,8.41,7.25,6.39,5.74
+63o,36.06,22.75,16.55,12.80,10.61,9.01,7.97,7.17,6.50
+53o,36.30,23.49,17.16,13.62,11.44,9.91,8.79,8.04,7.38
+43o,35.13,22.61,16.37,12.90,10.74,9.33,8.31,7.53,6.82
+33,53.77,33.64,24.00,19.01,16.36,14.57,13.51,12.68,12.05
+32s,36.04,23.90,18.20,15.01,13.08,11.71,10.70,9.86,9.16
+A2o,54.99,35.30,25.39,19.84,16.28,13.74,11.86,10.42,9.18
+K2o,50.49,31.21,22.09,16.95,13.86,11.60,9.97,8.67,7.67
+Q2o,47.30,28.63,20.12,15.45,12.49,10.40,8.93,7.71,6.84
+J2o,44.31,26.47,18.56,14.15,11.46,9.48,8.10,7.03,6.25
+T2o,41.67,24.71,17.35,13.19,10.70,8.85,7.55,6.53,5.80
+92o,39.08,23.17,16.08,12.09,9.72,8.09,6.86,5.95,5.21
+82o,36.81,21.79,15.18,11.43,9.16,7.58,6.45,5.60,5.00
+72o,34.58,20.46,14.25,10.69,8.63,7.20,6.19,5.41,4.79
+62o,34.05,20.77,14.72,11.16,9.16,7.70,6.79,5.99,5.40
+52o,34.32,21.44,15.45,12.08,10.04,8.58,7.64,6.86,6.25
+42o,33.16,20.71,14.77,11.53,9.59,8.24,7.37,6.60,5.98
+32o,32.31,19.74,13.97,10.83,8.94,7.66,6.83,6.11,5.59
+22,50.31,30.57,21.91,17.72,15.47,14.12,13.24,12.56,11.95
+`
@@ -0,0 +1,93 @@
+package deck_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"strings"
+	"testing"
+)
+
+func TestPreflopClasses(t *testing.T) {
+	classes := deck.PreflopClasses()
+	assert.Equal(t, 169, len(classes))
+
+	seen := map[string]bool{}
+	for _, c := range classes {
+		assert.False(t, seen[c], c)
+		seen[c] = true
+	}
+	// the first row of the grid, then the diagonal ends on deuces
+	assert.Equal(t, []string{"AA", "AKs", "AQs"}, classes[:3])
+	assert.Equal(t, "AKo", classes[13])
+	assert.Equal(t, "KK", classes[14])
+	assert.Equal(t, "22", classes[168])
+}
+
+func TestPreflopClass(t *testing.T) {
+
+	tests := []struct {
+		Hand     string
+		ExpClass string
+		ExpError error
+	}{
+		{Hand: "AsAh", ExpClass: "AA"},
+		{Hand: "KhAh", ExpClass: "AKs"},
+		{Hand: "7c2d", ExpClass: "72o"},
+		{Hand: "2d7c", ExpClass: "72o"},
+		{Hand: "As", ExpError: deck.ErrEquityHands},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Hand, func(t *testing.T) {
+			class, err := deck.PreflopClass(deck.NewHand(tt.Hand))
+			assert.Equal(t, tt.ExpError, err)
+			assert.Equal(t, tt.ExpClass, class)
+		})
+	}
+}
+
+func TestPreflopEquity(t *testing.T) {
+	aces, err := deck.PreflopEquity(deck.NewHand("AdAc"), 1)
+	assert.NoError(t, err)
+	assert.InDelta(t, 85.2, aces, 0.3)
+
+	// equity against a full table drops for every hand
+	full, err := deck.PreflopEquity(deck.NewHand("AdAc"), deck.MaxPreflopOpponents)
+	assert.NoError(t, err)
+	assert.Less(t, full, aces)
+
+	trash, err := deck.PreflopClassEquity("72o", 1)
+	assert.NoError(t, err)
+	assert.InDelta(t, 34.6, trash, 0.3)
+
+	_, err = deck.PreflopClassEquity("72o", 0)
+	assert.Equal(t, deck.ErrPreflopOpponents, err)
+	_, err = deck.PreflopClassEquity("27o", 1)
+	assert.True(t, errors.Is(err, deck.ErrInvalidRange))
+}
+
+func TestPreflopTable(t *testing.T) {
+	opts := deck.EquityOptions{Iterations: 100, Seed: 1, Workers: 1}
+	table := deck.GeneratePreflopTable(opts)
+	assert.Equal(t, 169, len(table))
+
+	// the same seed gives the same table no matter how many workers run
+	opts.Workers = 4
+	assert.Equal(t, table, deck.GeneratePreflopTable(opts))
+
+	// tables are written with 2 decimal places
+	buf := &bytes.Buffer{}
+	assert.NoError(t, deck.WritePreflopTable(buf, table))
+	parsed, err := deck.ParsePreflopTable(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	for class, equity := range table {
+		for i := range equity {
+			assert.InDelta(t, equity[i], parsed[class][i], 0.0051)
+		}
+	}
+
+	_, err = deck.ParsePreflopTable(strings.NewReader("class,1\nAA,85.2\n"))
+	assert.True(t, errors.Is(err, deck.ErrPreflopTable))
+}
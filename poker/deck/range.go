@@ -0,0 +1,456 @@
+package deck
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+	Hand ranges in the usual shorthand, separated by commas:
+
+		QQ          every combo of a pair
+		QQ+         pairs from queens up to aces
+		QQ-99       pairs from queens down to nines
+		AKs, AKo    suited or offsuit combos, AK is both
+		ATs+        suited aces with a ten kicker up to a king
+		A5s-A2s     suited aces with a five kicker down to a deuce
+		AhKh        a single combo
+
+	Any part can be weighted by the fraction of the time it's played, "AKo:0.5".
+	When a combo is listed more than once the last weight wins.
+*/
+
+var (
+	ErrInvalidRange  = errors.New("invalid range")
+	ErrEmptyRange    = errors.New("range has no combos left after card removal")
+	ErrRangeConflict = errors.New("ranges have no combos that can be dealt together")
+)
+
+// suit characters in the order combos are generated
+const suitChars = "shdc"
+
+// Combo is a 2 card starting hand in a range, weighted by how often it's played
+type Combo struct {
+	Hand   Hand
+	Weight float64
+}
+
+// Range is a set of weighted combos
+type Range []Combo
+
+// ParseRange parses a comma separated range such as "QQ+, AKs, A5s-A2s, KQo"
+func ParseRange(s string) (Range, error) {
+	out := Range{}
+	index := map[[2]Card]int{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		weight := 1.0
+		if i := strings.IndexByte(part, ':'); i >= 0 {
+			w, err := strconv.ParseFloat(part[i+1:], 64)
+			if err != nil || w <= 0 || w > 1 {
+				return nil, fmt.Errorf("%w: %q weight must be between 0 and 1", ErrInvalidRange, part)
+			}
+			weight = w
+			part = part[:i]
+		}
+
+		hands, err := parseRangePart(part)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hands {
+			// the higher card first so each combo has one key
+			if h[0] < h[1] {
+				h[0], h[1] = h[1], h[0]
+			}
+			key := [2]Card{h[0], h[1]}
+			if i, ok := index[key]; ok {
+				out[i].Weight = weight
+				continue
+			}
+			index[key] = len(out)
+			out = append(out, Combo{Hand: h, Weight: weight})
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%w: %q has no combos", ErrInvalidRange, s)
+	}
+	return out, nil
+}
+
+// Remove returns the combos that don't use any of the known cards
+func (r Range) Remove(known Hand) Range {
+	used := map[Card]bool{}
+	for _, c := range known {
+		used[c] = true
+	}
+	out := Range{}
+	for _, c := range r {
+		if !used[c.Hand[0]] && !used[c.Hand[1]] {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Combos is the weighted number of combos in the range
+func (r Range) Combos() float64 {
+	total := 0.0
+	for _, c := range r {
+		total += c.Weight
+	}
+	return total
+}
+
+func (r Range) String() string {
+	parts := make([]string, len(r))
+	for i, c := range r {
+		parts[i] = c.Hand.String()
+		if c.Weight != 1 {
+			parts[i] += ":" + strconv.FormatFloat(c.Weight, 'g', -1, 64)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseRangePart expands a single part of a range into its combos
+func parseRangePart(part string) ([]Hand, error) {
+	invalid := fmt.Errorf("%w: %q", ErrInvalidRange, part)
+
+	// a single combo such as AhKh
+	if len(part) == 4 && strings.IndexByte(suitChars, part[1]) >= 0 {
+		h, err := ParseHand(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRange, err)
+		}
+		if h[0] == h[1] {
+			return nil, invalid
+		}
+		return []Hand{h}, nil
+	}
+
+	first, last := part, ""
+	plus := strings.HasSuffix(part, "+")
+	if plus {
+		first = strings.TrimSuffix(part, "+")
+	} else if i := strings.IndexByte(part, '-'); i >= 0 {
+		first, last = part[:i], part[i+1:]
+	}
+
+	hi, lo, suits, ok := parseRangeHand(first)
+	if !ok {
+		return nil, invalid
+	}
+
+	// the ranks that change across the part, kickers for unpaired hands
+	from, to := lo, lo
+	switch {
+	case plus && hi == lo:
+		to = 12
+	case plus:
+		to = hi - 1
+	case last != "":
+		lastHi, lastLo, lastSuits, ok := parseRangeHand(last)
+		if !ok || lastSuits != suits || (hi == lo) != (lastHi == lastLo) || (hi != lo && lastHi != hi) {
+			return nil, invalid
+		}
+		from, to = lastLo, lo
+		if from > to {
+			from, to = to, from
+		}
+	}
+
+	out := []Hand{}
+	for rank := to; rank >= from; rank-- {
+		if hi == lo {
+			out = append(out, rangeCombos(rank, rank, suits)...)
+		} else {
+			out = append(out, rangeCombos(hi, rank, suits)...)
+		}
+	}
+	return out, nil
+}
+
+// parseRangeHand parses ranks with an optional suited or offsuit suffix such as "AKs".
+// suits is 's', 'o' or 0 for both
+func parseRangeHand(s string) (hi, lo int, suits byte, ok bool) {
+	if len(s) < 2 || len(s) > 3 {
+		return 0, 0, 0, false
+	}
+	hi = strings.IndexByte(rankChars, s[0])
+	lo = strings.IndexByte(rankChars, s[1])
+	if hi < 0 || lo < 0 {
+		return 0, 0, 0, false
+	}
+	if hi < lo {
+		hi, lo = lo, hi
+	}
+	if len(s) == 3 {
+		suits = s[2]
+		if (suits != 's' && suits != 'o') || hi == lo {
+			return 0, 0, 0, false
+		}
+	}
+	return hi, lo, suits, true
+}
+
+// rangeCombos returns every combo of the 2 ranks, limited to suited or offsuit combos
+func rangeCombos(hi, lo int, suits byte) []Hand {
+	out := []Hand{}
+	for i := 0; i < len(suitChars); i++ {
+		for j := 0; j < len(suitChars); j++ {
+			if hi == lo && j <= i {
+				continue
+			}
+			if (suits == 's' && i != j) || (suits == 'o' && i == j) {
+				continue
+			}
+			out = append(out, Hand{
+				newCard(uint32(hi), STRING_INT_TO_SUIT[suitChars[i]]),
+				newCard(uint32(lo), STRING_INT_TO_SUIT[suitChars[j]]),
+			})
+		}
+	}
+	return out
+}
+
+// RangeEquity calculates the hold'em equity of each range against the others given the known
+// board and dead cards. Combos that use a known card are removed from each range first.
+// Every deal of a combo from each range and a board is enumerated when there are few enough
+// of them, otherwise combos are sampled by weight with a board Monte Carlo.
+func RangeEquity(ranges []Range, board Hand, dead Hand, opts EquityOptions) (EquityResult, error) {
+	if len(ranges) < 2 {
+		return EquityResult{}, ErrEquityHands
+	}
+	if len(board) > 5 {
+		return EquityResult{}, ErrEquityBoard
+	}
+
+	known := append(append(Hand{}, board...), dead...)
+	used := map[Card]bool{}
+	for _, c := range known {
+		if used[c] {
+			return EquityResult{}, ErrDuplicateCard
+		}
+		used[c] = true
+	}
+
+	removed := make([]Range, len(ranges))
+	for i, r := range ranges {
+		removed[i] = r.Remove(known)
+		if len(removed[i]) == 0 {
+			return EquityResult{}, ErrEmptyRange
+		}
+	}
+
+	remaining := Deck{}
+	for _, c := range New() {
+		if !used[c] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	if opts.Iterations <= 0 {
+		opts.Iterations = DefaultEquityIterations
+	}
+	if opts.ExhaustiveLimit <= 0 {
+		opts.ExhaustiveLimit = DefaultExhaustiveLimit
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+
+	toDeal := 5 - len(board)
+	boards := binomial(len(remaining)-2*len(ranges), toDeal)
+
+	// the product of the range sizes is the most deals there can be before removing conflicts
+	deals := 1
+	for _, r := range removed {
+		deals *= len(r)
+		if deals*boards > opts.ExhaustiveLimit {
+			break
+		}
+	}
+
+	if deals*boards <= opts.ExhaustiveLimit {
+		dealt := rangeDeals(removed)
+		if len(dealt) == 0 {
+			return EquityResult{}, ErrRangeConflict
+		}
+		// chunk on the deal, each deal's boards count as its weight
+		total := runEquityChunks(len(dealt), opts.Workers, len(ranges), func(chunk int, t *equityTally) {
+			d := dealt[chunk]
+			e := &equityRun{
+				hands:     d.hands,
+				board:     board,
+				remaining: append(Deck{}, remaining...).without(d.hands),
+				toDeal:    toDeal,
+			}
+			t.weight = d.weight
+			chunks := len(e.remaining)
+			if toDeal == 0 {
+				chunks = 1
+			}
+			for first := 0; first < chunks; first++ {
+				e.enumerate(first, t)
+			}
+		})
+		return total.result(true), nil
+	}
+
+	if !rangesCanDeal(removed, 0, map[Card]bool{}) {
+		return EquityResult{}, ErrRangeConflict
+	}
+	samplers := make([]rangeSampler, len(removed))
+	for i, r := range removed {
+		samplers[i] = newRangeSampler(r)
+	}
+
+	chunks := (opts.Iterations + equityChunkSize - 1) / equityChunkSize
+	total := runEquityChunks(chunks, opts.Workers, len(ranges), func(chunk int, t *equityTally) {
+		n := equityChunkSize
+		if chunk == chunks-1 {
+			n = opts.Iterations - chunk*equityChunkSize
+		}
+		r := rand.New(rand.NewSource(opts.Seed + int64(chunk)))
+		e := &equityRun{
+			hands:     make([]Hand, len(ranges)),
+			board:     board,
+			remaining: make(Deck, 0, len(remaining)),
+			toDeal:    toDeal,
+		}
+		copy(t.board[:], board)
+		for ; n > 0; n-- {
+			// sample a combo from each range, starting again when any of them share a card
+			for {
+				for i, s := range samplers {
+					e.hands[i] = s.sample(r)
+				}
+				if !handsConflict(e.hands) {
+					break
+				}
+			}
+			e.remaining = append(e.remaining[:0], remaining...).without(e.hands)
+			for i := 0; i < toDeal; i++ {
+				j := i + r.Intn(len(e.remaining)-i)
+				e.remaining[i], e.remaining[j] = e.remaining[j], e.remaining[i]
+				t.board[len(board)+i] = e.remaining[i]
+			}
+			e.score(t)
+		}
+	})
+	return total.result(false), nil
+}
+
+// rangeDeal is one combo from each range, weighted by the product of the combo weights
+type rangeDeal struct {
+	hands  []Hand
+	weight float64
+}
+
+// rangeDeals returns every deal of a combo from each range that doesn't share a card
+func rangeDeals(ranges []Range) []rangeDeal {
+	out := []rangeDeal{}
+	hands := make([]Hand, len(ranges))
+	var deal func(i int, weight float64)
+	deal = func(i int, weight float64) {
+		if i == len(ranges) {
+			if !handsConflict(hands) {
+				out = append(out, rangeDeal{hands: append([]Hand{}, hands...), weight: weight})
+			}
+			return
+		}
+		for _, c := range ranges[i] {
+			hands[i] = c.Hand
+			deal(i+1, weight*c.Weight)
+		}
+	}
+	deal(0, 1)
+	return out
+}
+
+// rangesCanDeal returns true if a combo can be dealt from each range without sharing a card
+func rangesCanDeal(ranges []Range, i int, used map[Card]bool) bool {
+	if i == len(ranges) {
+		return true
+	}
+	for _, c := range ranges[i] {
+		if used[c.Hand[0]] || used[c.Hand[1]] {
+			continue
+		}
+		used[c.Hand[0]], used[c.Hand[1]] = true, true
+		ok := rangesCanDeal(ranges, i+1, used)
+		delete(used, c.Hand[0])
+		delete(used, c.Hand[1])
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func handsConflict(hands []Hand) bool {
+	for i := range hands {
+		for j := i + 1; j < len(hands); j++ {
+			for _, a := range hands[i] {
+				for _, b := range hands[j] {
+					if a == b {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// without removes the cards in the hands from the deck in place
+func (d Deck) without(hands []Hand) Deck {
+	out := d[:0]
+	for _, c := range d {
+		skip := false
+		for _, h := range hands {
+			if c == h[0] || c == h[1] {
+				skip = true
+			}
+		}
+		if !skip {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// rangeSampler samples combos from a range in proportion to their weight
+type rangeSampler struct {
+	combos     Range
+	cumulative []float64
+}
+
+func newRangeSampler(r Range) rangeSampler {
+	s := rangeSampler{combos: r, cumulative: make([]float64, len(r))}
+	total := 0.0
+	for i, c := range r {
+		total += c.Weight
+		s.cumulative[i] = total
+	}
+	return s
+}
+
+func (s rangeSampler) sample(r *rand.Rand) Hand {
+	x := r.Float64() * s.cumulative[len(s.cumulative)-1]
+	i := sort.SearchFloat64s(s.cumulative, x)
+	if i == len(s.combos) {
+		i--
+	}
+	return s.combos[i].Hand
+}
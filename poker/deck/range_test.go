@@ -0,0 +1,167 @@
+package deck_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+
+	tests := []struct {
+		Name      string
+		Range     string
+		ExpCombos int
+		ExpWeight float64
+		ExpError  bool
+	}{
+		{Name: "Pair", Range: "QQ", ExpCombos: 6, ExpWeight: 6},
+		{Name: "Pair and up", Range: "QQ+", ExpCombos: 18, ExpWeight: 18},
+		{Name: "Pairs between", Range: "QQ-99", ExpCombos: 24, ExpWeight: 24},
+		{Name: "Suited", Range: "AKs", ExpCombos: 4, ExpWeight: 4},
+		{Name: "Offsuit", Range: "AKo", ExpCombos: 12, ExpWeight: 12},
+		{Name: "Suited and offsuit", Range: "AK", ExpCombos: 16, ExpWeight: 16},
+		{Name: "Kicker and up", Range: "ATs+", ExpCombos: 16, ExpWeight: 16},
+		{Name: "Kickers between", Range: "A5s-A2s", ExpCombos: 16, ExpWeight: 16},
+		{Name: "Single combo", Range: "AhKh", ExpCombos: 1, ExpWeight: 1},
+		{Name: "Mixed", Range: "QQ+, AKs, A5s-A2s, KQo", ExpCombos: 50, ExpWeight: 50},
+		{Name: "Weighted", Range: "AKo:0.5", ExpCombos: 12, ExpWeight: 6},
+		{Name: "Last weight wins", Range: "AK, AKs:0.5", ExpCombos: 16, ExpWeight: 14},
+		{Name: "Single combo overlaps", Range: "AKs, KhAh:0.25", ExpCombos: 4, ExpWeight: 3.25},
+		{Name: "Duplicates", Range: "QQ+, KK", ExpCombos: 18, ExpWeight: 18},
+		{Name: "Unknown suffix", Range: "AKx", ExpError: true},
+		{Name: "Suited pair", Range: "QQs", ExpError: true},
+		{Name: "Different high cards", Range: "A5s-K2s", ExpError: true},
+		{Name: "Pair to unpaired", Range: "QQ-AK", ExpError: true},
+		{Name: "Weight too high", Range: "AA:2", ExpError: true},
+		{Name: "Same card twice", Range: "AhAh", ExpError: true},
+		{Name: "Unknown rank", Range: "XX", ExpError: true},
+		{Name: "Empty", Range: " , ", ExpError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			r, err := deck.ParseRange(tt.Range)
+			if tt.ExpError {
+				assert.True(t, errors.Is(err, deck.ErrInvalidRange))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpCombos, len(r))
+			assert.InDelta(t, tt.ExpWeight, r.Combos(), 1e-9)
+
+			// every combo is 2 different cards
+			for _, c := range r {
+				assert.Equal(t, 2, len(c.Hand))
+				assert.NotEqual(t, c.Hand[0], c.Hand[1])
+			}
+		})
+	}
+}
+
+func TestRange_Remove(t *testing.T) {
+	r, err := deck.ParseRange("AA, AKs")
+	assert.NoError(t, err)
+
+	removed := r.Remove(deck.NewHand("Ah2c"))
+	assert.Equal(t, 3+3, len(removed))
+	for _, c := range removed {
+		assert.NotContains(t, c.Hand.String(), "Ah")
+	}
+	// the original range is untouched
+	assert.Equal(t, 6+4, len(r))
+}
+
+func TestRangeEquity(t *testing.T) {
+	board := deck.NewHand("2h7h9cTs")
+
+	t.Run("Single combos match the hand equity", func(t *testing.T) {
+		hands := []deck.Hand{deck.NewHand("AhKh"), deck.NewHand("QsQd")}
+		exp, err := deck.CalculateEquity(hands, board, nil, deck.EquityOptions{})
+		assert.NoError(t, err)
+
+		out, err := deck.RangeEquity(ranges(t, "AhKh", "QsQd"), board, nil, deck.EquityOptions{})
+		assert.NoError(t, err)
+		assert.True(t, out.Exhaustive)
+		assert.Equal(t, exp.Boards, out.Boards)
+		for i := range exp.Hands {
+			assert.InDelta(t, exp.Hands[i].Equity, out.Hands[i].Equity, 1e-9)
+		}
+	})
+
+	t.Run("Combos are weighted", func(t *testing.T) {
+		// each deal has the same number of boards, so the range equity is the weighted average
+		exp := 0.0
+		for _, c := range []struct {
+			Hand   string
+			Weight float64
+		}{{"AcKc", 0.5}, {"AdKd", 1}} {
+			e, err := deck.CalculateEquity([]deck.Hand{deck.NewHand(c.Hand), deck.NewHand("QsQd")}, board, nil, deck.EquityOptions{})
+			assert.NoError(t, err)
+			exp += c.Weight * e.Hands[0].Equity / 1.5
+		}
+
+		out, err := deck.RangeEquity(ranges(t, "AcKc:0.5, AdKd", "QsQd"), board, nil, deck.EquityOptions{})
+		assert.NoError(t, err)
+		assert.True(t, out.Exhaustive)
+		assert.Equal(t, 2*44, out.Boards)
+		assert.InDelta(t, exp, out.Hands[0].Equity, 1e-9)
+		assert.InDelta(t, 100, out.Hands[0].Equity+out.Hands[1].Equity, 1e-9)
+	})
+
+	t.Run("Conflicting combos aren't dealt", func(t *testing.T) {
+		// AsQh can only be dealt against the 3 queen combos without the Qh
+		out, err := deck.RangeEquity(ranges(t, "QQ", "AsQh, AdAc"), board, nil, deck.EquityOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, (3+6)*44, out.Boards)
+	})
+
+	t.Run("Card removal", func(t *testing.T) {
+		out, err := deck.RangeEquity(ranges(t, "99", "TT"), board, nil, deck.EquityOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3*3*44, out.Boards)
+		// sets of nines against sets of tens
+		assert.Less(t, out.Hands[0].Equity, 5.0)
+	})
+
+	t.Run("Monte Carlo", func(t *testing.T) {
+		opts := deck.EquityOptions{Iterations: 20000, Seed: 3, Workers: 1}
+		out, err := deck.RangeEquity(ranges(t, "QQ+, AKs", "22+, AJo+"), nil, nil, opts)
+		assert.NoError(t, err)
+		assert.False(t, out.Exhaustive)
+		assert.Equal(t, 20000, out.Boards)
+		assert.Greater(t, out.Hands[0].Equity, 60.0)
+		assert.InDelta(t, 100, out.Hands[0].Equity+out.Hands[1].Equity, 1e-9)
+
+		// the same seed gives the same result no matter how many workers run
+		opts.Workers = 4
+		parallel, err := deck.RangeEquity(ranges(t, "QQ+, AKs", "22+, AJo+"), nil, nil, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, out, parallel)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := deck.RangeEquity(ranges(t, "AhAs"), nil, nil, deck.EquityOptions{})
+		assert.Equal(t, deck.ErrEquityHands, err)
+
+		_, err = deck.RangeEquity(ranges(t, "AhAs", "KK"), nil, deck.NewHand("As"), deck.EquityOptions{})
+		assert.Equal(t, deck.ErrEmptyRange, err)
+
+		_, err = deck.RangeEquity(ranges(t, "AhAs", "AhKh"), nil, nil, deck.EquityOptions{})
+		assert.Equal(t, deck.ErrRangeConflict, err)
+
+		_, err = deck.RangeEquity(ranges(t, "AA", "KK"), deck.NewHand("2c2c"), nil, deck.EquityOptions{})
+		assert.Equal(t, deck.ErrDuplicateCard, err)
+	})
+}
+
+func ranges(t *testing.T, in ...string) []deck.Range {
+	out := []deck.Range{}
+	for _, s := range in {
+		r, err := deck.ParseRange(s)
+		assert.NoError(t, err)
+		out = append(out, r)
+	}
+	return out
+}
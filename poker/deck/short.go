@@ -0,0 +1,75 @@
+package deck
+
+/*
+   Short deck (6+) hand evaluation.
+
+   With the 2s through 5s removed flushes are harder to make than full houses,
+   so flushes beat full houses, and A-6-7-8-9 plays as the lowest straight.
+
+   Scores stay in the range [1, 7462] by re-using the standard lookups:
+   - A-6-7-8-9 takes the place of the 5-4-3-2-A wheel, which can't be made with a short deck
+   - The 1277 flushes move ahead of the 156 full houses
+
+   Straight Flush   1    - 10
+   Four of a Kind   11   - 166
+   Flush            167  - 1443
+   Full Houses      1444 - 1599
+   Straight         1600 - 1609
+   ...the rest are the same as the standard ranking
+*/
+
+const (
+	worstFullHouse     = uint32(322)
+	worstFlush         = uint32(1599)
+	fullHouses         = uint32(156)
+	flushes            = uint32(1277)
+	wheelStraight      = uint32(1609)
+	wheelStraightFlush = uint32(10)
+
+	// A, 9, 8, 7, 6 rank bits
+	shortWheelRankbits = uint32(1<<12 | 1<<7 | 1<<6 | 1<<5 | 1<<4)
+)
+
+// ShortDeckLogic scores the best 5 card hand using short deck rankings
+func ShortDeckLogic(args []string) uint32 {
+	return evaluateShortDeck(cardsFromStrings(args))
+}
+
+func evaluateShortDeck(cards []Card) uint32 {
+	possible_hands := hand_permutations(cards, HANDSIZE_TO_PERMUTATION_MAP[len(cards)])
+
+	best_score := uint32(7462)
+	for _, hand := range possible_hands {
+		handscore := five_short(hand)
+		if handscore < best_score {
+			best_score = handscore
+		}
+	}
+	return best_score
+}
+
+// EvaluateShortDeck scores the hand using short deck rankings
+func (h Hand) EvaluateShortDeck() uint32 {
+	return evaluateShortDeck(h)
+}
+
+func five_short(cards []Card) uint32 {
+	isFlush := cards[0]&cards[1]&cards[2]&cards[3]&cards[4]&0xF000 != 0
+	handOR := uint32(cards[0]|cards[1]|cards[2]|cards[3]|cards[4]) >> 16
+	if handOR == shortWheelRankbits {
+		if isFlush {
+			return wheelStraightFlush
+		}
+		return wheelStraight
+	}
+
+	score := five(cards)
+	switch {
+	case score > worstFullHouse && score <= worstFlush:
+		// flushes move ahead of full houses
+		return score - fullHouses
+	case score > worstFullHouse-fullHouses && score <= worstFullHouse:
+		return score + flushes
+	}
+	return score
+}
@@ -0,0 +1,82 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestDeck_NewShort(t *testing.T) {
+	d := deck.NewShort()
+	assert.Equal(t, deck.ShortDeckSize, len(d))
+	assert.True(t, d.IsFull(deck.ShortDeckSize))
+	assert.False(t, d.IsFull(deck.FullDeckSize))
+	assert.Equal(t, "6", d[0].Type())
+	assert.Equal(t, "h", d[0].Suit())
+	for _, c := range d {
+		assert.NotContains(t, []string{"2", "3", "4", "5"}, c.Type())
+	}
+
+	d = deck.Deck{}.Marshal(d.String())
+	assert.Equal(t, deck.ShortDeckSize, len(d))
+
+	_, d = deck.DealCard(d)
+	assert.False(t, d.IsFull(deck.ShortDeckSize))
+}
+
+func TestDeck_ShortDeckLogic(t *testing.T) {
+
+	tests := []struct {
+		Name   string
+		better []string
+		worse  []string
+	}{
+		{
+			Name:   "Flush beats a full house",
+			better: []string{"6h", "8h", "9h", "Jh", "Kh"},
+			worse:  []string{"As", "Ad", "Ac", "Ks", "Kd"},
+		},
+		{
+			Name:   "Worst full house beats a straight",
+			better: []string{"6s", "6d", "6c", "7s", "7d"},
+			worse:  []string{"As", "Kd", "Qc", "Js", "Td"},
+		},
+		{
+			Name:   "Quads beat the best flush",
+			better: []string{"6s", "6d", "6c", "6h", "7d"},
+			worse:  []string{"Ah", "Kh", "Qh", "Jh", "9h"},
+		},
+		{
+			Name:   "A-6-7-8-9 is a straight",
+			better: []string{"As", "6d", "7c", "8s", "9d"},
+			worse:  []string{"Ts", "Td", "Tc", "Ks", "Ad"},
+		},
+		{
+			Name:   "A-6-7-8-9 is the lowest straight",
+			better: []string{"6s", "7d", "8c", "9s", "Td"},
+			worse:  []string{"As", "6d", "7c", "8s", "9d"},
+		},
+		{
+			Name:   "A-6-7-8-9 suited is a straight flush",
+			better: []string{"As", "6s", "7s", "8s", "9s"},
+			worse:  []string{"Ah", "Ad", "Ac", "As", "Kd"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			better := deck.ShortDeckLogic(tt.better)
+			worse := deck.ShortDeckLogic(tt.worse)
+			assert.Less(t, better, worse)
+		})
+	}
+
+	assert.Equal(t, 1, int(deck.ShortDeckLogic([]string{"As", "Ks", "Qs", "Js", "Ts", "6d", "7c"})))
+	assert.Equal(t, 10, int(deck.ShortDeckLogic([]string{"As", "6s", "7s", "8s", "9s"})))
+	assert.Equal(t, 167, int(deck.ShortDeckLogic([]string{"Ah", "Kh", "Qh", "Jh", "9h"})))
+	assert.Equal(t, 1444, int(deck.ShortDeckLogic([]string{"As", "Ad", "Ac", "Ks", "Kd"})))
+	assert.Equal(t, 1609, int(deck.ShortDeckLogic([]string{"As", "6d", "7c", "8s", "9d"})))
+
+	hand := deck.NewHand("As6d7c8s9dKhKd")
+	assert.Equal(t, 1609, int(hand.EvaluateShortDeck()))
+}
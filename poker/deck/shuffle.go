@@ -0,0 +1,51 @@
+package deck
+
+import (
+	"crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"sync"
+)
+
+// Shuffler shuffles a deck in place and returns it
+type Shuffler interface {
+	Shuffle(d Deck) Deck
+}
+
+// CryptoShuffler is a Fisher-Yates shuffle backed by crypto/rand.
+// This is the shuffler that should be used for real games
+type CryptoShuffler struct{}
+
+func (CryptoShuffler) Shuffle(d Deck) Deck {
+	for i := len(d) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			// the system's secure random source is unavailable, dealing with anything less is not an option
+			panic(err)
+		}
+		j := int(n.Int64())
+		d[i], d[j] = d[j], d[i]
+	}
+	return d
+}
+
+// SeededShuffler is a deterministic Fisher-Yates shuffle, the same seed will always produce
+// the same order of shuffles. It is not safe for real games and is intended for tests
+type SeededShuffler struct {
+	mu   sync.Mutex
+	rand *mrand.Rand
+}
+
+func NewSeededShuffler(seed int64) *SeededShuffler {
+	return &SeededShuffler{rand: mrand.New(mrand.NewSource(seed))}
+}
+
+func (s *SeededShuffler) Shuffle(d Deck) Deck {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(d) - 1; i > 0; i-- {
+		j := s.rand.Intn(i + 1)
+		d[i], d[j] = d[j], d[i]
+	}
+	return d
+}
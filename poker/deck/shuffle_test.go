@@ -0,0 +1,57 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"sort"
+	"testing"
+)
+
+func TestShuffler(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		Shuffler deck.Shuffler
+	}{
+		{
+			Name:     "Crypto shuffler",
+			Shuffler: deck.CryptoShuffler{},
+		},
+		{
+			Name:     "Seeded shuffler",
+			Shuffler: deck.NewSeededShuffler(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			d := tt.Shuffler.Shuffle(deck.New())
+			assert.Equal(t, deck.FullDeckSize, len(d))
+			assert.NotEqual(t, deck.New().String(), d.String())
+
+			// every card is still in the deck exactly once
+			cards := []string{}
+			for _, c := range d {
+				cards = append(cards, c.String())
+			}
+			expected := []string{}
+			for _, c := range deck.New() {
+				expected = append(expected, c.String())
+			}
+			sort.Strings(cards)
+			sort.Strings(expected)
+			assert.Equal(t, expected, cards)
+		})
+	}
+}
+
+func TestSeededShuffler_Deterministic(t *testing.T) {
+	a := deck.NewSeededShuffler(42)
+	b := deck.NewSeededShuffler(42)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, a.Shuffle(deck.New()).String(), b.Shuffle(deck.New()).String())
+	}
+	assert.NotEqual(t,
+		deck.NewSeededShuffler(42).Shuffle(deck.New()).String(),
+		deck.NewSeededShuffler(43).Shuffle(deck.New()).String())
+}
@@ -0,0 +1,231 @@
+package deck
+
+import "sort"
+
+/*
+   Seven card stud and razz helpers.
+
+   Razz is played for the A-5 low: aces are always low, straights and flushes
+   don't count against a hand, but pairs do. Every 5 card rank combination is a
+   valid razz hand so there are as many razz hand values as unsuited hands:
+
+   No pair     1287
+   One pair    2860
+   Two pair    858
+   Trips       858
+   Full house  156
+   Quads       156
+   -------------------------
+   TOTAL       6175
+
+   Like the high hand lookups, razz hands are keyed on the unique prime product of the
+   5 ranks, which maps to a rank in range [1, 6175]:
+
+   = 5-4-3-2-A = 1
+   = K-K-K-K-Q = 6175
+*/
+
+const WorstRazz = uint32(6175)
+
+// suit order used to break ties for the bring-in, clubs are lowest and spades highest
+// keyed on the card's suit bits
+var SUIT_ORDER = map[uint32]int{
+	8: 0, // c
+	4: 1, // d
+	2: 2, // h
+	1: 3, // s
+}
+
+var RAZZ_LOOKUP = make(map[uint32]uint32)
+
+func init() {
+	RAZZ_LOOKUP = razz_lookup()
+}
+
+// razz_lookup generates every 5 card combination of ranks and ranks it as an A-5 low
+func razz_lookup() map[uint32]uint32 {
+	type razzHand struct {
+		counts []int
+		ranks  []int
+		prime  uint32
+	}
+	hands := []razzHand{}
+
+	// ace low values 1-13 (A, 2, ... K)
+	var gen func(start int, values []int)
+	gen = func(start int, values []int) {
+		if len(values) == 5 {
+			if values[0] == values[4] {
+				// 5 of a kind
+				return
+			}
+			counts, ranks := groupValues(values)
+			prime := uint32(1)
+			for _, v := range values {
+				prime *= lowValuePrime(v)
+			}
+			hands = append(hands, razzHand{counts: counts, ranks: ranks, prime: prime})
+			return
+		}
+		for v := start; v <= 13; v++ {
+			gen(v, append(append([]int{}, values...), v))
+		}
+	}
+	gen(1, []int{})
+
+	sort.Slice(hands, func(i, j int) bool {
+		if c := compareInts(hands[i].counts, hands[j].counts); c != 0 {
+			return c < 0
+		}
+		return compareInts(hands[i].ranks, hands[j].ranks) < 0
+	})
+
+	out := make(map[uint32]uint32, len(hands))
+	for i, h := range hands {
+		out[h.prime] = uint32(i + 1)
+	}
+	return out
+}
+
+// RazzLogic scores the best A-5 low using any 5 of the cards
+func RazzLogic(args []string) uint32 {
+	return evaluateRazz(cardsFromStrings(args))
+}
+
+func evaluateRazz(cards []Card) uint32 {
+	best_score := WorstRazz
+	for _, hand := range hand_permutations(cards, HANDSIZE_TO_PERMUTATION_MAP[len(cards)]) {
+		if handscore, ok := RAZZ_LOOKUP[prime_product_from_hand(hand)]; ok && handscore < best_score {
+			best_score = handscore
+		}
+	}
+	return best_score
+}
+
+// EvaluateRazz scores the best A-5 low in the hand
+func (h Hand) EvaluateRazz() uint32 {
+	return evaluateRazz(h)
+}
+
+// BringIn returns the index of the up card that must bring in for seven card stud.
+// The lowest card brings in, with ties broken by suit.
+func BringIn(upcards []Card) int {
+	best := 0
+	for i, c := range upcards {
+		rank, bestRank := c.Rank(), upcards[best].Rank()
+		if rank < bestRank || (rank == bestRank && suitOrder(c) < suitOrder(upcards[best])) {
+			best = i
+		}
+	}
+	return best
+}
+
+// RazzBringIn returns the index of the up card that must bring in for razz.
+// The highest card brings in (aces are low), with ties broken by suit.
+func RazzBringIn(upcards []Card) int {
+	best := 0
+	for i, c := range upcards {
+		rank, bestRank := lowValue(c), lowValue(upcards[best])
+		if rank > bestRank || (rank == bestRank && suitOrder(c) > suitOrder(upcards[best])) {
+			best = i
+		}
+	}
+	return best
+}
+
+// BestShowing returns the index of the best high hand showing, which acts first on later streets of seven card stud.
+// Only pairs, trips and quads count when comparing up cards, ties go to the first hand.
+func BestShowing(showing []Hand) int {
+	best := 0
+	for i := range showing {
+		if compareShowing(showing[i], showing[best], false) > 0 {
+			best = i
+		}
+	}
+	return best
+}
+
+// BestShowingLow returns the index of the best A-5 low showing, which acts first on later streets of razz.
+// ties go to the first hand.
+func BestShowingLow(showing []Hand) int {
+	best := 0
+	for i := range showing {
+		if compareShowing(showing[i], showing[best], true) < 0 {
+			best = i
+		}
+	}
+	return best
+}
+
+// compareShowing compares the up cards of two hands returning > 0 if a is the higher hand
+func compareShowing(a, b Hand, aceLow bool) int {
+	aCounts, aRanks := groupValues(showingValues(a, aceLow))
+	bCounts, bRanks := groupValues(showingValues(b, aceLow))
+	if c := compareInts(aCounts, bCounts); c != 0 {
+		return c
+	}
+	return compareInts(aRanks, bRanks)
+}
+
+func showingValues(cards Hand, aceLow bool) []int {
+	values := make([]int, len(cards))
+	for i, c := range cards {
+		if aceLow {
+			values[i] = lowValue(c)
+		} else {
+			values[i] = int(c.Rank())
+		}
+	}
+	return values
+}
+
+// groupValues returns the size of each group of matching values largest first,
+// and the value of each group ordered by the size of group then highest value
+func groupValues(values []int) (counts []int, ranks []int) {
+	m := map[int]int{}
+	for _, v := range values {
+		m[v] += 1
+	}
+	for v := range m {
+		ranks = append(ranks, v)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if m[ranks[i]] != m[ranks[j]] {
+			return m[ranks[i]] > m[ranks[j]]
+		}
+		return ranks[i] > ranks[j]
+	})
+	for _, v := range ranks {
+		counts = append(counts, m[v])
+	}
+	return counts, ranks
+}
+
+func compareInts(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return len(a) - len(b)
+}
+
+func suitOrder(c Card) int {
+	return SUIT_ORDER[uint32(c>>12)&0xF]
+}
+
+// lowValue returns the ace low value of a card's rank, A is 1 and K is 13
+func lowValue(c Card) int {
+	r := int(c.Rank())
+	if r == 12 {
+		return 1
+	}
+	return r + 2
+}
+
+func lowValuePrime(v int) uint32 {
+	if v == 1 {
+		return PRIMES[12]
+	}
+	return PRIMES[v-2]
+}
@@ -0,0 +1,111 @@
+package deck_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"grpc_texas_holdem/poker/deck"
+	"testing"
+)
+
+func TestDeck_RazzLogic(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		hand     []string
+		ExpScore uint32
+	}{
+		{
+			Name:     "The wheel is the best razz hand even when suited",
+			hand:     []string{"5s", "4s", "3s", "2s", "As"},
+			ExpScore: 1,
+		},
+		{
+			Name:     "Worst razz hand",
+			hand:     []string{"Ks", "Kd", "Kc", "Kh", "Qs"},
+			ExpScore: deck.WorstRazz,
+		},
+		{
+			Name:     "6 low",
+			hand:     []string{"6s", "4s", "3d", "2s", "As"},
+			ExpScore: 2,
+		},
+		{
+			Name:     "Worst hand without a pair",
+			hand:     []string{"Ks", "Qd", "Jc", "Th", "9s"},
+			ExpScore: 1287,
+		},
+		{
+			Name:     "Best paired hand",
+			hand:     []string{"As", "Ad", "2c", "3h", "4s"},
+			ExpScore: 1288,
+		},
+		{
+			Name:     "Best 5 of 7",
+			hand:     []string{"Ks", "Kd", "7c", "5h", "3s", "2d", "Ac"},
+			ExpScore: deck.RazzLogic([]string{"7c", "5h", "3s", "2d", "Ac"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, int(tt.ExpScore), int(deck.RazzLogic(tt.hand)))
+		})
+	}
+
+	// lower pairs beat higher pairs, then the kickers are compared
+	assert.Less(t, deck.RazzLogic([]string{"2s", "2d", "Kc", "Qh", "Js"}), deck.RazzLogic([]string{"3s", "3d", "Ac", "2h", "4s"}))
+	assert.Less(t, deck.RazzLogic([]string{"8s", "7d", "6c", "5h", "4s"}), deck.RazzLogic([]string{"8s", "7d", "6c", "5h", "Ts"}))
+	assert.Less(t, deck.RazzLogic([]string{"8s", "5d", "4c", "3h", "2s"}), deck.RazzLogic([]string{"8s", "6d", "4c", "3h", "2s"}))
+	assert.Equal(t, 1, int(deck.NewHand("Kh5s4s3s2sAsKd").EvaluateRazz()))
+}
+
+func TestDeck_BringIn(t *testing.T) {
+	// lowest card brings in for stud, ties broken by suit (clubs are lowest)
+	assert.Equal(t, 2, deck.BringIn(deck.NewHand("As7d2h9c")))
+	assert.Equal(t, 1, deck.BringIn(deck.NewHand("2h2c2s9c")))
+
+	// highest card brings in for razz, aces are low and ties are broken by suit (spades are highest)
+	assert.Equal(t, 3, deck.RazzBringIn(deck.NewHand("Ah7d2h9c")))
+	assert.Equal(t, 2, deck.RazzBringIn(deck.NewHand("KhKcKs9c")))
+}
+
+func TestDeck_BestShowing(t *testing.T) {
+
+	tests := []struct {
+		Name    string
+		showing []deck.Hand
+		ExpHigh int
+		ExpLow  int
+	}{
+		{
+			Name:    "High card",
+			showing: []deck.Hand{deck.NewHand("Ks"), deck.NewHand("As"), deck.NewHand("2d")},
+			ExpHigh: 1,
+			ExpLow:  1,
+		},
+		{
+			Name:    "Pair beats high cards",
+			showing: []deck.Hand{deck.NewHand("KsAd"), deck.NewHand("2s2d"), deck.NewHand("5d3c")},
+			ExpHigh: 1,
+			ExpLow:  2,
+		},
+		{
+			Name:    "Two pair beats a higher pair",
+			showing: []deck.Hand{deck.NewHand("AsAdKcQd"), deck.NewHand("3s3d2c2h"), deck.NewHand("Ah2s3h4d")},
+			ExpHigh: 1,
+			ExpLow:  2,
+		},
+		{
+			Name:    "Ties go to the first hand",
+			showing: []deck.Hand{deck.NewHand("Kd7c"), deck.NewHand("Ks7d")},
+			ExpHigh: 0,
+			ExpLow:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpHigh, deck.BestShowing(tt.showing))
+			assert.Equal(t, tt.ExpLow, deck.BestShowingLow(tt.showing))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// StreetEquity is a single player's equity at one street of an all in run out
+type StreetEquity struct {
+	gorm.Model
+	Round  int64
+	Run    int32
+	Status string
+	Board  string
+	Player int64
+	Hand   string
+	Win    float64
+	Tie    float64
+	Equity float64
+}
+
+// StreetEquitiesUnMarshal gets the db rows of a street's equity, 1 per hand
+func StreetEquitiesUnMarshal(round int64, e *pb.StreetEquity) []*StreetEquity {
+	out := []*StreetEquity{}
+	for _, h := range e.GetHands() {
+		out = append(out, &StreetEquity{
+			Round:  round,
+			Run:    e.GetRun(),
+			Status: e.GetStatus().String(),
+			Board:  e.GetBoard(),
+			Player: h.GetPlayer(),
+			Hand:   h.GetHand(),
+			Win:    h.GetWin(),
+			Tie:    h.GetTie(),
+			Equity: h.GetEquity(),
+		})
+	}
+	return out
+}
+
+// StreetEquitiesMarshal groups the rows back into streets, expects rows in the order they were created
+func StreetEquitiesMarshal(rows []*StreetEquity) []*pb.StreetEquity {
+	out := []*pb.StreetEquity{}
+	var last *pb.StreetEquity
+	for _, r := range rows {
+		status := pb.RoundStatus(pb.RoundStatus_value[r.Status])
+		if last == nil || last.GetRun() != r.Run || last.GetStatus() != status {
+			last = &pb.StreetEquity{
+				Run:    r.Run,
+				Status: status,
+				Board:  r.Board,
+			}
+			out = append(out, last)
+		}
+		last.Hands = append(last.Hands, &pb.HandEquity{
+			Player: r.Player,
+			Hand:   r.Hand,
+			Win:    r.Win,
+			Tie:    r.Tie,
+			Equity: r.Equity,
+		})
+	}
+	return out
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// HandEvent is an entry in a round's event log, events are only ever appended
+type HandEvent struct {
+	gorm.Model
+	Game    int64
+	Round   int64
+	Seq     int64
+	Type    string
+	Status  string
+	Player  int64
+	Slot    int64
+	Chips   int64
+	Cards   string
+	UpCards string
+	Bet     string
+	Deck    string
+	Runs    int32
+
+	// the game's settings, only set on START
+	Min          int64
+	SmallBlind   int64
+	BigBlind     int64
+	MinBet       int64
+	RakePercent  float64
+	RakeCap      int64
+	NoFlopNoDrop bool
+	Variant      string
+	Limit        string
+	Training     bool
+	MaxSeats     int64
+}
+
+// ProtoUnMarshal gets db representation of the protobuf
+func (e *HandEvent) ProtoUnMarshal(event *pb.HandEvent) {
+	e.Model.ID = uint(event.GetId())
+	e.Game = event.GetGame()
+	e.Round = event.GetRound()
+	e.Seq = event.GetSeq()
+	e.Type = event.GetType().String()
+	e.Status = event.GetStatus().String()
+	e.Player = event.GetPlayer()
+	e.Slot = event.GetSlot()
+	e.Chips = event.GetChips()
+	e.Cards = event.GetCards()
+	e.UpCards = event.GetUpCards()
+	e.Bet = event.GetBet().String()
+	e.Deck = event.GetDeck()
+	e.Runs = event.GetRuns()
+	if settings := event.GetSettings(); settings != nil {
+		e.Min = settings.GetMin()
+		e.SmallBlind = settings.GetSmallBlind()
+		e.BigBlind = settings.GetBigBlind()
+		e.MinBet = settings.GetMinBet()
+		e.RakePercent = settings.GetRakePercent()
+		e.RakeCap = settings.GetRakeCap()
+		e.NoFlopNoDrop = settings.GetNoFlopNoDrop()
+		e.Variant = settings.GetVariant().String()
+		e.Limit = settings.GetLimit().String()
+		e.Training = settings.GetTraining()
+		e.MaxSeats = settings.GetMaxSeats()
+	}
+}
+
+// ProtoMarshal gets the protobuf representation of the DB
+func (e *HandEvent) ProtoMarshal() *pb.HandEvent {
+	out := &pb.HandEvent{
+		Id:      int64(e.Model.ID),
+		Game:    e.Game,
+		Round:   e.Round,
+		Seq:     e.Seq,
+		Type:    pb.HandEventType(pb.HandEventType_value[e.Type]),
+		Status:  pb.RoundStatus(pb.RoundStatus_value[e.Status]),
+		Player:  e.Player,
+		Slot:    e.Slot,
+		Chips:   e.Chips,
+		Cards:   e.Cards,
+		UpCards: e.UpCards,
+		Bet:     pb.Bet_BetType(pb.Bet_BetType_value[e.Bet]),
+		Deck:    e.Deck,
+		Time:    e.CreatedAt.UnixNano() / 1e6,
+		Runs:    e.Runs,
+	}
+	if out.Type == pb.HandEventType_START {
+		out.Settings = &pb.Game{
+			Min:          e.Min,
+			SmallBlind:   e.SmallBlind,
+			BigBlind:     e.BigBlind,
+			MinBet:       e.MinBet,
+			RakePercent:  e.RakePercent,
+			RakeCap:      e.RakeCap,
+			NoFlopNoDrop: e.NoFlopNoDrop,
+			Variant:      pb.GameVariant(pb.GameVariant_value[e.Variant]),
+			Limit:        pb.BetLimit(pb.BetLimit_value[e.Limit]),
+			Training:     e.Training,
+			MaxSeats:     e.MaxSeats,
+		}
+	}
+	return out
+}
@@ -12,6 +12,18 @@ type Game struct {
 	Dealer  int64
 	Min     int64
 	InRound bool
+	// SmallBlind and BigBlind override the Min derived blinds when set
+	SmallBlind int64
+	BigBlind   int64
+	MinBet     int64
+	// Rake configuration, applied when the pot is settled
+	RakePercent  float64
+	RakeCap      int64
+	NoFlopNoDrop bool
+	Variant      string
+	Limit        string
+	Training     bool
+	MaxSeats     int64
 }
 
 // ProtoUnMarshal gets db representation of the protobuf
@@ -21,6 +33,16 @@ func (g *Game) ProtoUnMarshal(game *pb.Game) {
 	g.Dealer = game.GetDealer()
 	g.Min = game.GetMin()
 	g.InRound = game.GetInRound()
+	g.SmallBlind = game.GetSmallBlind()
+	g.BigBlind = game.GetBigBlind()
+	g.MinBet = game.GetMinBet()
+	g.RakePercent = game.GetRakePercent()
+	g.RakeCap = game.GetRakeCap()
+	g.NoFlopNoDrop = game.GetNoFlopNoDrop()
+	g.Variant = game.GetVariant().String()
+	g.Limit = game.GetLimit().String()
+	g.Training = game.GetTraining()
+	g.MaxSeats = game.GetMaxSeats()
 }
 
 // ProtoMarshal gets the protobuf representation of the DB
@@ -31,16 +53,33 @@ func (g *Game) ProtoMarshal() *pb.Game {
 		Dealer:  g.Dealer,
 		Min:     g.Min,
 		InRound: g.InRound,
+
+		SmallBlind: g.SmallBlind,
+		BigBlind:   g.BigBlind,
+		MinBet:     g.MinBet,
+
+		RakePercent:  g.RakePercent,
+		RakeCap:      g.RakeCap,
+		NoFlopNoDrop: g.NoFlopNoDrop,
+		Variant:      pb.GameVariant(pb.GameVariant_value[g.Variant]),
+		Limit:        pb.BetLimit(pb.BetLimit_value[g.Limit]),
+		Training:     g.Training,
+		MaxSeats:     g.MaxSeats,
 	}
 }
 
 func (g *Game) MarshalRound() *Round {
 	d := deck.New()
+	if g.Variant == pb.GameVariant_SHORT_DECK.String() {
+		d = deck.NewShort()
+	}
 
 	return &Round{
 		// Id is nil as it will be created
-		Deck: d.String(),
-		Game: int64(g.ID),
+		Deck:     d.String(),
+		Game:     int64(g.ID),
+		Variant:  g.Variant,
+		Training: g.Training,
 	}
 
 }
@@ -7,11 +7,14 @@ import (
 
 type Player struct {
 	gorm.Model
-	Name   string
-	Chips  int64
-	Slot   int64
+	Name  string
+	Chips int64
+	Slot  int64
+	// in the string format like the round's cards, see Round
 	Cards  string
 	InHand bool
+	// stud games only, the cards dealt face up
+	UpCards string
 }
 
 type GamePlayers struct {
@@ -27,6 +30,7 @@ func (p *Player) ProtoUnMarshal(player *pb.Player) {
 	p.Slot = player.GetSlot()
 	p.InHand = player.GetInHand()
 	p.Cards = player.GetCards()
+	p.UpCards = player.GetUpCards()
 
 }
 
@@ -39,6 +43,8 @@ func (p *Player) ProtoMarshal() *pb.Player {
 		Slot:   p.Slot,
 		InHand: p.InHand,
 		Cards:  p.Cards,
+
+		UpCards: p.UpCards,
 	}
 }
 
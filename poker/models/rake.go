@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// Rake is a ledger entry of the house cut taken from a single hand
+type Rake struct {
+	gorm.Model
+	Game  int64
+	Round int64
+	Pot   int64
+	Chips int64
+}
+
+// ProtoUnMarshal gets db representation of the protobuf
+func (r *Rake) ProtoUnMarshal(rake *pb.Rake) {
+	r.Model.ID = uint(rake.GetId())
+	r.Game = rake.GetGame()
+	r.Round = rake.GetRound()
+	r.Pot = rake.GetPot()
+	r.Chips = rake.GetChips()
+}
+
+// ProtoMarshal gets the protobuf representation of the DB
+func (r *Rake) ProtoMarshal() *pb.Rake {
+	return &pb.Rake{
+		Id:    int64(r.Model.ID),
+		Game:  r.Game,
+		Round: r.Round,
+		Pot:   r.Pot,
+		Chips: r.Chips,
+	}
+}
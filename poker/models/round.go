@@ -1,23 +1,50 @@
 package models
 
 import (
+	"strings"
+
 	"github.com/jinzhu/gorm"
 	pb "grpc_texas_holdem/poker/protobufs"
 )
 
-// db representation of a round of 1 sequence of dealing out cards
+// db representation of a round of 1 sequence of dealing out cards.
+// Cards are stored in their string format, eg. "AsKd", not the deck package's 32-bit encoding.
+// It's the format the protos, event log and hand histories use, it reads in the db, and a
+// deck or board is one column rather than a row per card. The server parses and validates
+// cards with deck.ParseDeck and deck.ParseHand before they're written, and works with
+// the 32-bit encoding once they're read back.
 type Round struct {
 	gorm.Model
-	Deck   string
-	Status string
-	Flop   string
-	Turn   string
-	River  string
-	Game   int64
-	Action int64
+	Deck          string
+	Status        string
+	Flop          string
+	Turn          string
+	River         string
+	Game          int64
+	Action        int64
 	WinningPlayer int64
 	WinningHand   string
-	WinningScore uint32
+	WinningScore  uint32
+	Variant       string
+
+	WinningLowPlayer int64
+	WinningLowScore  uint32
+
+	Commitment string
+	ServerSeed string
+	ClientSeed string
+
+	WinningCategory string
+	WinningHandName string
+	WinningBestHand string
+
+	AllIn bool
+	// slot of the dealer button when the round started
+	Dealer int64
+	// copied from the game when the round is created
+	Training bool
+	// comma separated boards, only set when the board was run more than once
+	RunBoards string
 }
 
 type RoundPlayers struct {
@@ -25,6 +52,19 @@ type RoundPlayers struct {
 	Round  int64
 	Player int64
 	Game   int64
+	// times the player agreed to run the board if everyone is all in, 0 if they haven't
+	Runs int32
+	// snapshot of the player when the round started, since players are updated in place
+	Slot    int64
+	Chips   int64
+	Cards   string
+	UpCards string
+	// chips awarded to the player when the pot was settled
+	Won int64
+	// the player's hand as it was evaluated, only set for hands shown down
+	HandCategory string
+	HandName     string
+	BestHand     string
 }
 
 func (r *Round) ProtoUnMarshal(round *pb.Round) {
@@ -39,21 +79,58 @@ func (r *Round) ProtoUnMarshal(round *pb.Round) {
 	r.WinningHand = round.GetWinningHand()
 	r.WinningPlayer = round.GetWinningPlayer()
 	r.WinningScore = round.GetWinningScore()
+	r.Variant = round.GetVariant().String()
+	r.WinningLowPlayer = round.GetWinningLowPlayer()
+	r.WinningLowScore = round.GetWinningLowScore()
+	// left empty until there's a winner so updates don't clear it
+	if round.GetWinningCategory() != pb.HandCategory_NO_HAND {
+		r.WinningCategory = round.GetWinningCategory().String()
+	}
+	r.WinningHandName = round.GetWinningHandName()
+	r.WinningBestHand = round.GetWinningBestHand()
+	r.AllIn = round.GetAllIn()
+	r.Dealer = round.GetDealer()
+	r.Training = round.GetTraining()
+	r.RunBoards = strings.Join(round.GetRunBoards(), ",")
+	// the commitment and seeds are only ever written when the round and its deck are created
 }
 
 // ProtoMarshal gets the protobuf representation of the DB
 func (p *Round) ProtoMarshal() *pb.Round {
-	return &pb.Round{
-		Id:     int64(p.Model.ID),
-		Deck:   p.Deck,
-		Flop:   p.Flop,
-		Turn:   p.Turn,
-		River:  p.River,
-		Game:   p.Game,
-		Status: pb.RoundStatus(pb.RoundStatus_value[p.Status]),
-		Action: p.Action,
-		WinningHand:p.WinningHand,
-		WinningScore:p.WinningScore,
-		WinningPlayer:p.WinningPlayer,
+	out := &pb.Round{
+		Id:            int64(p.Model.ID),
+		Deck:          p.Deck,
+		Flop:          p.Flop,
+		Turn:          p.Turn,
+		River:         p.River,
+		Game:          p.Game,
+		Status:        pb.RoundStatus(pb.RoundStatus_value[p.Status]),
+		Action:        p.Action,
+		WinningHand:   p.WinningHand,
+		WinningScore:  p.WinningScore,
+		WinningPlayer: p.WinningPlayer,
+		Variant:       pb.GameVariant(pb.GameVariant_value[p.Variant]),
+
+		WinningLowPlayer: p.WinningLowPlayer,
+		WinningLowScore:  p.WinningLowScore,
+
+		Commitment: p.Commitment,
+		ClientSeed: p.ClientSeed,
+
+		WinningCategory: pb.HandCategory(pb.HandCategory_value[p.WinningCategory]),
+		WinningHandName: p.WinningHandName,
+		WinningBestHand: p.WinningBestHand,
+
+		AllIn:    p.AllIn,
+		Dealer:   p.Dealer,
+		Training: p.Training,
+	}
+	if p.RunBoards != "" {
+		out.RunBoards = strings.Split(p.RunBoards, ",")
+	}
+	// the server seed stays secret until the hand is over
+	if out.Status == pb.RoundStatus_OVER {
+		out.ServerSeed = p.ServerSeed
 	}
+	return out
 }
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// Season is a window of time leaderboards rank players over
+type Season struct {
+	gorm.Model
+	Name  string
+	Start time.Time
+	End   time.Time
+}
+
+// ProtoUnMarshal gets db representation of the protobuf
+func (s *Season) ProtoUnMarshal(season *pb.Season) {
+	s.Model.ID = uint(season.GetId())
+	s.Name = season.GetName()
+	s.Start = time.Unix(0, season.GetStart()*1e6)
+	s.End = time.Unix(0, season.GetEnd()*1e6)
+}
+
+// ProtoMarshal gets the protobuf representation of the DB
+func (s *Season) ProtoMarshal() *pb.Season {
+	return &pb.Season{
+		Id:    int64(s.Model.ID),
+		Name:  s.Name,
+		Start: s.Start.UnixNano() / 1e6,
+		End:   s.End.UnixNano() / 1e6,
+	}
+}
+
+// Standing is a player's running totals for a variant in a season, added to as each hand is settled
+type Standing struct {
+	gorm.Model
+	Season  int64
+	Player  int64
+	Variant string
+	Net     int64
+	Hands   int64
+	Points  int64
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// PlayerHand is what a player did in a settled hand, a row is added for each player as hands complete
+// so stats over any set of hands are sums of the rows
+type PlayerHand struct {
+	gorm.Model
+	Game     int64
+	Round    int64
+	Player   int64
+	BigBlind int64
+	// voluntarily put chips in the pot on the first street
+	Vpip bool
+	// raised on the first street
+	Pfr bool
+	// faced a single first street raise, and re-raised it
+	ThreeBetChance bool
+	ThreeBet       bool
+	// raises and calls of chips after the first street
+	Aggressive int64
+	Calls      int64
+	// saw the flop, or fourth street in stud
+	SawFlop     bool
+	Showdown    bool
+	WonShowdown bool
+	// chips won less chips bet
+	Net int64
+}
@@ -0,0 +1,12 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Waitlist is a player waiting for a seat at a full game, players are seated in the order they joined
+type Waitlist struct {
+	gorm.Model
+	Game   int64
+	Player int64
+}
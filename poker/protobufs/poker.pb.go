@@ -25,6 +25,161 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type GameVariant int32
+
+const (
+	GameVariant_HOLDEM          GameVariant = 0
+	GameVariant_OMAHA           GameVariant = 1
+	GameVariant_HOLDEM_HI_LO    GameVariant = 2
+	GameVariant_OMAHA_HI_LO     GameVariant = 3
+	GameVariant_SHORT_DECK      GameVariant = 4
+	GameVariant_SEVEN_CARD_STUD GameVariant = 5
+	GameVariant_RAZZ            GameVariant = 6
+)
+
+var GameVariant_name = map[int32]string{
+	0: "HOLDEM",
+	1: "OMAHA",
+	2: "HOLDEM_HI_LO",
+	3: "OMAHA_HI_LO",
+	4: "SHORT_DECK",
+	5: "SEVEN_CARD_STUD",
+	6: "RAZZ",
+}
+
+var GameVariant_value = map[string]int32{
+	"HOLDEM":          0,
+	"OMAHA":           1,
+	"HOLDEM_HI_LO":    2,
+	"OMAHA_HI_LO":     3,
+	"SHORT_DECK":      4,
+	"SEVEN_CARD_STUD": 5,
+	"RAZZ":            6,
+}
+
+func (x GameVariant) String() string {
+	return proto.EnumName(GameVariant_name, int32(x))
+}
+
+func (GameVariant) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{0}
+}
+
+type BetLimit int32
+
+const (
+	BetLimit_DEFAULT_LIMIT BetLimit = 0
+	BetLimit_NO_LIMIT      BetLimit = 1
+	BetLimit_POT_LIMIT     BetLimit = 2
+)
+
+var BetLimit_name = map[int32]string{
+	0: "DEFAULT_LIMIT",
+	1: "NO_LIMIT",
+	2: "POT_LIMIT",
+}
+
+var BetLimit_value = map[string]int32{
+	"DEFAULT_LIMIT": 0,
+	"NO_LIMIT":      1,
+	"POT_LIMIT":     2,
+}
+
+func (x BetLimit) String() string {
+	return proto.EnumName(BetLimit_name, int32(x))
+}
+
+func (BetLimit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{1}
+}
+
+type HandCategory int32
+
+const (
+	HandCategory_NO_HAND         HandCategory = 0
+	HandCategory_HIGH_CARD       HandCategory = 1
+	HandCategory_ONE_PAIR        HandCategory = 2
+	HandCategory_TWO_PAIR        HandCategory = 3
+	HandCategory_THREE_OF_A_KIND HandCategory = 4
+	HandCategory_STRAIGHT        HandCategory = 5
+	HandCategory_FLUSH           HandCategory = 6
+	HandCategory_FULL_HOUSE      HandCategory = 7
+	HandCategory_FOUR_OF_A_KIND  HandCategory = 8
+	HandCategory_STRAIGHT_FLUSH  HandCategory = 9
+)
+
+var HandCategory_name = map[int32]string{
+	0: "NO_HAND",
+	1: "HIGH_CARD",
+	2: "ONE_PAIR",
+	3: "TWO_PAIR",
+	4: "THREE_OF_A_KIND",
+	5: "STRAIGHT",
+	6: "FLUSH",
+	7: "FULL_HOUSE",
+	8: "FOUR_OF_A_KIND",
+	9: "STRAIGHT_FLUSH",
+}
+
+var HandCategory_value = map[string]int32{
+	"NO_HAND":         0,
+	"HIGH_CARD":       1,
+	"ONE_PAIR":        2,
+	"TWO_PAIR":        3,
+	"THREE_OF_A_KIND": 4,
+	"STRAIGHT":        5,
+	"FLUSH":           6,
+	"FULL_HOUSE":      7,
+	"FOUR_OF_A_KIND":  8,
+	"STRAIGHT_FLUSH":  9,
+}
+
+func (x HandCategory) String() string {
+	return proto.EnumName(HandCategory_name, int32(x))
+}
+
+func (HandCategory) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{2}
+}
+
+type Draw int32
+
+const (
+	Draw_NO_DRAW    Draw = 0
+	Draw_FLUSH_DRAW Draw = 1
+	// includes double gutshots
+	Draw_OPEN_ENDED_STRAIGHT_DRAW Draw = 2
+	Draw_GUTSHOT                  Draw = 3
+	Draw_BACKDOOR_FLUSH_DRAW      Draw = 4
+	Draw_BACKDOOR_STRAIGHT_DRAW   Draw = 5
+)
+
+var Draw_name = map[int32]string{
+	0: "NO_DRAW",
+	1: "FLUSH_DRAW",
+	2: "OPEN_ENDED_STRAIGHT_DRAW",
+	3: "GUTSHOT",
+	4: "BACKDOOR_FLUSH_DRAW",
+	5: "BACKDOOR_STRAIGHT_DRAW",
+}
+
+var Draw_value = map[string]int32{
+	"NO_DRAW":                  0,
+	"FLUSH_DRAW":               1,
+	"OPEN_ENDED_STRAIGHT_DRAW": 2,
+	"GUTSHOT":                  3,
+	"BACKDOOR_FLUSH_DRAW":      4,
+	"BACKDOOR_STRAIGHT_DRAW":   5,
+}
+
+func (x Draw) String() string {
+	return proto.EnumName(Draw_name, int32(x))
+}
+
+func (Draw) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{3}
+}
+
 type RoundStatus int32
 
 const (
@@ -35,26 +190,42 @@ const (
 	RoundStatus_TURN        RoundStatus = 4
 	RoundStatus_SHOW        RoundStatus = 5
 	RoundStatus_OVER        RoundStatus = 6
+	// stud games bet on each street instead of PRE_FLOP-TURN
+	RoundStatus_THIRD_STREET   RoundStatus = 7
+	RoundStatus_FOURTH_STREET  RoundStatus = 8
+	RoundStatus_FIFTH_STREET   RoundStatus = 9
+	RoundStatus_SIXTH_STREET   RoundStatus = 10
+	RoundStatus_SEVENTH_STREET RoundStatus = 11
 )
 
 var RoundStatus_name = map[int32]string{
-	0: "NOT_STARTED",
-	1: "PRE_FLOP",
-	2: "FLOP",
-	3: "RIVER",
-	4: "TURN",
-	5: "SHOW",
-	6: "OVER",
+	0:  "NOT_STARTED",
+	1:  "PRE_FLOP",
+	2:  "FLOP",
+	3:  "RIVER",
+	4:  "TURN",
+	5:  "SHOW",
+	6:  "OVER",
+	7:  "THIRD_STREET",
+	8:  "FOURTH_STREET",
+	9:  "FIFTH_STREET",
+	10: "SIXTH_STREET",
+	11: "SEVENTH_STREET",
 }
 
 var RoundStatus_value = map[string]int32{
-	"NOT_STARTED": 0,
-	"PRE_FLOP":    1,
-	"FLOP":        2,
-	"RIVER":       3,
-	"TURN":        4,
-	"SHOW":        5,
-	"OVER":        6,
+	"NOT_STARTED":    0,
+	"PRE_FLOP":       1,
+	"FLOP":           2,
+	"RIVER":          3,
+	"TURN":           4,
+	"SHOW":           5,
+	"OVER":           6,
+	"THIRD_STREET":   7,
+	"FOURTH_STREET":  8,
+	"FIFTH_STREET":   9,
+	"SIXTH_STREET":   10,
+	"SEVENTH_STREET": 11,
 }
 
 func (x RoundStatus) String() string {
@@ -62,18 +233,101 @@ func (x RoundStatus) String() string {
 }
 
 func (RoundStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_818c499f6358623d, []int{0}
+	return fileDescriptor_818c499f6358623d, []int{4}
+}
+
+type HandEventType int32
+
+const (
+	// the round started, with the dealer button, the deck left after the hole cards were dealt
+	// and the game's settings
+	HandEventType_START HandEventType = 0
+	// a player's seat and starting stack
+	HandEventType_SEAT HandEventType = 1
+	// cards dealt to a player, or to the board when there's no player.
+	// Extra runs of an all in board are dealt as a whole board each
+	HandEventType_DEAL HandEventType = 2
+	HandEventType_BET  HandEventType = 3
+	// the round moved on to the status
+	HandEventType_STREET HandEventType = 4
+	// chips awarded to a player from the pot
+	HandEventType_SETTLE HandEventType = 5
+	// a player agreed to run the board a number of times if everyone is all in
+	HandEventType_RUNS HandEventType = 6
+	// a player's cards shown down when more than one player is left in the hand
+	HandEventType_SHOWDOWN HandEventType = 7
+)
+
+var HandEventType_name = map[int32]string{
+	0: "START",
+	1: "SEAT",
+	2: "DEAL",
+	3: "BET",
+	4: "STREET",
+	5: "SETTLE",
+	6: "RUNS",
+	7: "SHOWDOWN",
+}
+
+var HandEventType_value = map[string]int32{
+	"START":    0,
+	"SEAT":     1,
+	"DEAL":     2,
+	"BET":      3,
+	"STREET":   4,
+	"SETTLE":   5,
+	"RUNS":     6,
+	"SHOWDOWN": 7,
+}
+
+func (x HandEventType) String() string {
+	return proto.EnumName(HandEventType_name, int32(x))
+}
+
+func (HandEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{5}
+}
+
+type LeaderboardRanking int32
+
+const (
+	LeaderboardRanking_NET   LeaderboardRanking = 0
+	LeaderboardRanking_HANDS LeaderboardRanking = 1
+	// a point for each player in a hand the player finished ahead of on net chips,
+	// a stand in until there are tournaments to award points
+	LeaderboardRanking_POINTS LeaderboardRanking = 2
+)
+
+var LeaderboardRanking_name = map[int32]string{
+	0: "NET",
+	1: "HANDS",
+	2: "POINTS",
+}
+
+var LeaderboardRanking_value = map[string]int32{
+	"NET":    0,
+	"HANDS":  1,
+	"POINTS": 2,
+}
+
+func (x LeaderboardRanking) String() string {
+	return proto.EnumName(LeaderboardRanking_name, int32(x))
+}
+
+func (LeaderboardRanking) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{6}
 }
 
 type Bet_BetType int32
 
 const (
-	Bet_NONE  Bet_BetType = 0
-	Bet_FOLD  Bet_BetType = 1
-	Bet_CALL  Bet_BetType = 2
-	Bet_RAISE Bet_BetType = 3
-	Bet_SMALL Bet_BetType = 4
-	Bet_BIG   Bet_BetType = 5
+	Bet_NONE     Bet_BetType = 0
+	Bet_FOLD     Bet_BetType = 1
+	Bet_CALL     Bet_BetType = 2
+	Bet_RAISE    Bet_BetType = 3
+	Bet_SMALL    Bet_BetType = 4
+	Bet_BIG      Bet_BetType = 5
+	Bet_BRING_IN Bet_BetType = 6
 )
 
 var Bet_BetType_name = map[int32]string{
@@ -83,15 +337,17 @@ var Bet_BetType_name = map[int32]string{
 	3: "RAISE",
 	4: "SMALL",
 	5: "BIG",
+	6: "BRING_IN",
 }
 
 var Bet_BetType_value = map[string]int32{
-	"NONE":  0,
-	"FOLD":  1,
-	"CALL":  2,
-	"RAISE": 3,
-	"SMALL": 4,
-	"BIG":   5,
+	"NONE":     0,
+	"FOLD":     1,
+	"CALL":     2,
+	"RAISE":    3,
+	"SMALL":    4,
+	"BIG":      5,
+	"BRING_IN": 6,
 }
 
 func (x Bet_BetType) String() string {
@@ -99,7 +355,7 @@ func (x Bet_BetType) String() string {
 }
 
 func (Bet_BetType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_818c499f6358623d, []int{7, 0}
+	return fileDescriptor_818c499f6358623d, []int{16, 0}
 }
 
 // convenience method, not saved in db
@@ -176,10 +432,18 @@ type Player struct {
 	InHand bool   `protobuf:"varint,5,opt,name=in_hand,json=inHand,proto3" json:"in_hand,omitempty"`
 	Cards  string `protobuf:"bytes,6,opt,name=cards,proto3" json:"cards,omitempty"`
 	// not saved in DB, used when evaluating hand
-	Score                uint32   `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Score uint32 `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	// not saved in DB, 8 or better low score in hi-lo games, 0 means no qualifying low
+	LowScore uint32 `protobuf:"varint,8,opt,name=low_score,json=lowScore,proto3" json:"low_score,omitempty"`
+	// stud games only, the cards dealt face up
+	UpCards string `protobuf:"bytes,9,opt,name=up_cards,json=upCards,proto3" json:"up_cards,omitempty"`
+	// not saved in DB, set at showdown to describe the best 5 cards
+	HandCategory         HandCategory `protobuf:"varint,10,opt,name=hand_category,json=handCategory,proto3,enum=poker.HandCategory" json:"hand_category,omitempty"`
+	HandName             string       `protobuf:"bytes,11,opt,name=hand_name,json=handName,proto3" json:"hand_name,omitempty"`
+	BestHand             string       `protobuf:"bytes,12,opt,name=best_hand,json=bestHand,proto3" json:"best_hand,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Player) Reset()         { *m = Player{} }
@@ -256,6 +520,41 @@ func (m *Player) GetScore() uint32 {
 	return 0
 }
 
+func (m *Player) GetLowScore() uint32 {
+	if m != nil {
+		return m.LowScore
+	}
+	return 0
+}
+
+func (m *Player) GetUpCards() string {
+	if m != nil {
+		return m.UpCards
+	}
+	return ""
+}
+
+func (m *Player) GetHandCategory() HandCategory {
+	if m != nil {
+		return m.HandCategory
+	}
+	return HandCategory_NO_HAND
+}
+
+func (m *Player) GetHandName() string {
+	if m != nil {
+		return m.HandName
+	}
+	return ""
+}
+
+func (m *Player) GetBestHand() string {
+	if m != nil {
+		return m.BestHand
+	}
+	return ""
+}
+
 type Players struct {
 	Players              []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -299,11 +598,29 @@ type Game struct {
 	Players *Players `protobuf:"bytes,1,opt,name=players,proto3" json:"players,omitempty"`
 	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// button positions
-	Id                   int64    `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	Dealer               int64    `protobuf:"varint,4,opt,name=dealer,proto3" json:"dealer,omitempty"`
-	Min                  int64    `protobuf:"varint,5,opt,name=min,proto3" json:"min,omitempty"`
-	Rounds               *Rounds  `protobuf:"bytes,6,opt,name=rounds,proto3" json:"rounds,omitempty"`
-	InRound              bool     `protobuf:"varint,7,opt,name=in_round,json=inRound,proto3" json:"in_round,omitempty"`
+	Id      int64   `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Dealer  int64   `protobuf:"varint,4,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	Min     int64   `protobuf:"varint,5,opt,name=min,proto3" json:"min,omitempty"`
+	Rounds  *Rounds `protobuf:"bytes,6,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	InRound bool    `protobuf:"varint,7,opt,name=in_round,json=inRound,proto3" json:"in_round,omitempty"`
+	// explicit blind structure, when unset blinds are derived from min (small = min, big = min*2)
+	SmallBlind int64 `protobuf:"varint,8,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"`
+	BigBlind   int64 `protobuf:"varint,9,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`
+	// minimum amount a raise must increase the bet by, 0 means any raise is allowed
+	MinBet int64 `protobuf:"varint,10,opt,name=min_bet,json=minBet,proto3" json:"min_bet,omitempty"`
+	// house cut taken from each pot at settlement
+	RakePercent float64 `protobuf:"fixed64,11,opt,name=rake_percent,json=rakePercent,proto3" json:"rake_percent,omitempty"`
+	// max chips raked from a single pot, 0 means no cap
+	RakeCap int64 `protobuf:"varint,12,opt,name=rake_cap,json=rakeCap,proto3" json:"rake_cap,omitempty"`
+	// no rake is taken from a hand that ends before the flop
+	NoFlopNoDrop bool        `protobuf:"varint,13,opt,name=no_flop_no_drop,json=noFlopNoDrop,proto3" json:"no_flop_no_drop,omitempty"`
+	Variant      GameVariant `protobuf:"varint,14,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	// when unset the variant's default is used (no limit hold'em, pot limit omaha)
+	Limit BetLimit `protobuf:"varint,15,opt,name=limit,proto3,enum=poker.BetLimit" json:"limit,omitempty"`
+	// training mode shows every player's outs and draws on the flop and turn
+	Training bool `protobuf:"varint,16,opt,name=training,proto3" json:"training,omitempty"`
+	// seats at the table, 2, 6, 8, 9 or 10. When unset the table has 8 seats
+	MaxSeats             int64    `protobuf:"varint,17,opt,name=max_seats,json=maxSeats,proto3" json:"max_seats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -383,6 +700,76 @@ func (m *Game) GetInRound() bool {
 	return false
 }
 
+func (m *Game) GetSmallBlind() int64 {
+	if m != nil {
+		return m.SmallBlind
+	}
+	return 0
+}
+
+func (m *Game) GetBigBlind() int64 {
+	if m != nil {
+		return m.BigBlind
+	}
+	return 0
+}
+
+func (m *Game) GetMinBet() int64 {
+	if m != nil {
+		return m.MinBet
+	}
+	return 0
+}
+
+func (m *Game) GetRakePercent() float64 {
+	if m != nil {
+		return m.RakePercent
+	}
+	return 0
+}
+
+func (m *Game) GetRakeCap() int64 {
+	if m != nil {
+		return m.RakeCap
+	}
+	return 0
+}
+
+func (m *Game) GetNoFlopNoDrop() bool {
+	if m != nil {
+		return m.NoFlopNoDrop
+	}
+	return false
+}
+
+func (m *Game) GetVariant() GameVariant {
+	if m != nil {
+		return m.Variant
+	}
+	return GameVariant_HOLDEM
+}
+
+func (m *Game) GetLimit() BetLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return BetLimit_DEFAULT_LIMIT
+}
+
+func (m *Game) GetTraining() bool {
+	if m != nil {
+		return m.Training
+	}
+	return false
+}
+
+func (m *Game) GetMaxSeats() int64 {
+	if m != nil {
+		return m.MaxSeats
+	}
+	return 0
+}
+
 type Games struct {
 	Games                []*Game  `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -422,416 +809,2765 @@ func (m *Games) GetGames() []*Game {
 	return nil
 }
 
-// any info here is only relevant within a particular hand
-type Round struct {
-	Id      int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status  RoundStatus `protobuf:"varint,2,opt,name=status,proto3,enum=poker.RoundStatus" json:"status,omitempty"`
-	Players *Players    `protobuf:"bytes,3,opt,name=players,proto3" json:"players,omitempty"`
-	Deck    string      `protobuf:"bytes,4,opt,name=deck,proto3" json:"deck,omitempty"`
-	Flop    string      `protobuf:"bytes,5,opt,name=flop,proto3" json:"flop,omitempty"`
-	Turn    string      `protobuf:"bytes,6,opt,name=turn,proto3" json:"turn,omitempty"`
-	River   string      `protobuf:"bytes,7,opt,name=river,proto3" json:"river,omitempty"`
-	Bets    *Bets       `protobuf:"bytes,8,opt,name=bets,proto3" json:"bets,omitempty"`
-	Game    int64       `protobuf:"varint,9,opt,name=game,proto3" json:"game,omitempty"`
-	// Slot of person who has to bet
-	Action               int64    `protobuf:"varint,10,opt,name=action,proto3" json:"action,omitempty"`
-	WinningPlayer        int64    `protobuf:"varint,11,opt,name=winning_player,json=winningPlayer,proto3" json:"winning_player,omitempty"`
-	WinningHand          string   `protobuf:"bytes,12,opt,name=winning_hand,json=winningHand,proto3" json:"winning_hand,omitempty"`
-	WinningScore         uint32   `protobuf:"varint,13,opt,name=winning_score,json=winningScore,proto3" json:"winning_score,omitempty"`
+// players waiting for a seat at a full game
+type Waitlist struct {
+	Game int64 `protobuf:"varint,1,opt,name=game,proto3" json:"game,omitempty"`
+	// in the order they'll be seated
+	Players              *Players `protobuf:"bytes,2,opt,name=players,proto3" json:"players,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Round) Reset()         { *m = Round{} }
-func (m *Round) String() string { return proto.CompactTextString(m) }
-func (*Round) ProtoMessage()    {}
-func (*Round) Descriptor() ([]byte, []int) {
+func (m *Waitlist) Reset()         { *m = Waitlist{} }
+func (m *Waitlist) String() string { return proto.CompactTextString(m) }
+func (*Waitlist) ProtoMessage()    {}
+func (*Waitlist) Descriptor() ([]byte, []int) {
 	return fileDescriptor_818c499f6358623d, []int{5}
 }
 
-func (m *Round) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Round.Unmarshal(m, b)
+func (m *Waitlist) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Waitlist.Unmarshal(m, b)
 }
-func (m *Round) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Round.Marshal(b, m, deterministic)
+func (m *Waitlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Waitlist.Marshal(b, m, deterministic)
 }
-func (m *Round) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Round.Merge(m, src)
+func (m *Waitlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Waitlist.Merge(m, src)
 }
-func (m *Round) XXX_Size() int {
-	return xxx_messageInfo_Round.Size(m)
+func (m *Waitlist) XXX_Size() int {
+	return xxx_messageInfo_Waitlist.Size(m)
 }
-func (m *Round) XXX_DiscardUnknown() {
-	xxx_messageInfo_Round.DiscardUnknown(m)
+func (m *Waitlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_Waitlist.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Round proto.InternalMessageInfo
+var xxx_messageInfo_Waitlist proto.InternalMessageInfo
 
-func (m *Round) GetId() int64 {
+func (m *Waitlist) GetGame() int64 {
 	if m != nil {
-		return m.Id
+		return m.Game
 	}
 	return 0
 }
 
-func (m *Round) GetStatus() RoundStatus {
-	if m != nil {
-		return m.Status
-	}
-	return RoundStatus_NOT_STARTED
-}
-
-func (m *Round) GetPlayers() *Players {
+func (m *Waitlist) GetPlayers() *Players {
 	if m != nil {
 		return m.Players
 	}
 	return nil
 }
 
-func (m *Round) GetDeck() string {
+// a game as it's shown in the lobby
+type LobbyGame struct {
+	Id         int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variant    GameVariant `protobuf:"varint,3,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	Limit      BetLimit    `protobuf:"varint,4,opt,name=limit,proto3,enum=poker.BetLimit" json:"limit,omitempty"`
+	SmallBlind int64       `protobuf:"varint,5,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"`
+	BigBlind   int64       `protobuf:"varint,6,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`
+	// players in the game and the seats at the table
+	Players int64 `protobuf:"varint,7,opt,name=players,proto3" json:"players,omitempty"`
+	Seats   int64 `protobuf:"varint,8,opt,name=seats,proto3" json:"seats,omitempty"`
+	InRound bool  `protobuf:"varint,9,opt,name=in_round,json=inRound,proto3" json:"in_round,omitempty"`
+	// only set on a watch, the game no longer matches the watch's filters and can be taken off
+	// the list. It's sent once, with the game's latest state, until the game matches again
+	Unmatched            bool     `protobuf:"varint,10,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LobbyGame) Reset()         { *m = LobbyGame{} }
+func (m *LobbyGame) String() string { return proto.CompactTextString(m) }
+func (*LobbyGame) ProtoMessage()    {}
+func (*LobbyGame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{6}
+}
+
+func (m *LobbyGame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LobbyGame.Unmarshal(m, b)
+}
+func (m *LobbyGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LobbyGame.Marshal(b, m, deterministic)
+}
+func (m *LobbyGame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LobbyGame.Merge(m, src)
+}
+func (m *LobbyGame) XXX_Size() int {
+	return xxx_messageInfo_LobbyGame.Size(m)
+}
+func (m *LobbyGame) XXX_DiscardUnknown() {
+	xxx_messageInfo_LobbyGame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LobbyGame proto.InternalMessageInfo
+
+func (m *LobbyGame) GetId() int64 {
 	if m != nil {
-		return m.Deck
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *Round) GetFlop() string {
+func (m *LobbyGame) GetName() string {
 	if m != nil {
-		return m.Flop
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Round) GetTurn() string {
+func (m *LobbyGame) GetVariant() GameVariant {
 	if m != nil {
-		return m.Turn
+		return m.Variant
 	}
-	return ""
+	return GameVariant_HOLDEM
 }
 
-func (m *Round) GetRiver() string {
+func (m *LobbyGame) GetLimit() BetLimit {
 	if m != nil {
-		return m.River
+		return m.Limit
 	}
-	return ""
+	return BetLimit_DEFAULT_LIMIT
 }
 
-func (m *Round) GetBets() *Bets {
+func (m *LobbyGame) GetSmallBlind() int64 {
 	if m != nil {
-		return m.Bets
+		return m.SmallBlind
 	}
-	return nil
+	return 0
 }
 
-func (m *Round) GetGame() int64 {
+func (m *LobbyGame) GetBigBlind() int64 {
 	if m != nil {
-		return m.Game
+		return m.BigBlind
 	}
 	return 0
 }
 
-func (m *Round) GetAction() int64 {
+func (m *LobbyGame) GetPlayers() int64 {
 	if m != nil {
-		return m.Action
+		return m.Players
 	}
 	return 0
 }
 
-func (m *Round) GetWinningPlayer() int64 {
+func (m *LobbyGame) GetSeats() int64 {
 	if m != nil {
-		return m.WinningPlayer
+		return m.Seats
 	}
 	return 0
 }
 
-func (m *Round) GetWinningHand() string {
+func (m *LobbyGame) GetInRound() bool {
 	if m != nil {
-		return m.WinningHand
+		return m.InRound
 	}
-	return ""
+	return false
 }
 
-func (m *Round) GetWinningScore() uint32 {
+func (m *LobbyGame) GetUnmatched() bool {
 	if m != nil {
-		return m.WinningScore
+		return m.Unmatched
 	}
-	return 0
+	return false
 }
 
-type Rounds struct {
-	Rounds               []*Round `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+// filters for the games in the lobby, unset filters match every game
+type ListGamesRequest struct {
+	// list only games of the variant
+	ByVariant bool        `protobuf:"varint,1,opt,name=by_variant,json=byVariant,proto3" json:"by_variant,omitempty"`
+	Variant   GameVariant `protobuf:"varint,2,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	BigBlind  int64       `protobuf:"varint,3,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`
+	// games with at least this many open seats
+	SeatsAvailable int64 `protobuf:"varint,4,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"`
+	// list only games that are, or aren't, in a round
+	ByInRound bool `protobuf:"varint,5,opt,name=by_in_round,json=byInRound,proto3" json:"by_in_round,omitempty"`
+	InRound   bool `protobuf:"varint,6,opt,name=in_round,json=inRound,proto3" json:"in_round,omitempty"`
+	// pages are numbered from 0, a page size of 0 uses the default
+	Page                 int32    `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32    `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Rounds) Reset()         { *m = Rounds{} }
-func (m *Rounds) String() string { return proto.CompactTextString(m) }
-func (*Rounds) ProtoMessage()    {}
-func (*Rounds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_818c499f6358623d, []int{6}
+func (m *ListGamesRequest) Reset()         { *m = ListGamesRequest{} }
+func (m *ListGamesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGamesRequest) ProtoMessage()    {}
+func (*ListGamesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{7}
 }
 
-func (m *Rounds) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Rounds.Unmarshal(m, b)
+func (m *ListGamesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGamesRequest.Unmarshal(m, b)
 }
-func (m *Rounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Rounds.Marshal(b, m, deterministic)
+func (m *ListGamesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGamesRequest.Marshal(b, m, deterministic)
 }
-func (m *Rounds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Rounds.Merge(m, src)
+func (m *ListGamesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGamesRequest.Merge(m, src)
 }
-func (m *Rounds) XXX_Size() int {
-	return xxx_messageInfo_Rounds.Size(m)
+func (m *ListGamesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGamesRequest.Size(m)
 }
-func (m *Rounds) XXX_DiscardUnknown() {
-	xxx_messageInfo_Rounds.DiscardUnknown(m)
+func (m *ListGamesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGamesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Rounds proto.InternalMessageInfo
+var xxx_messageInfo_ListGamesRequest proto.InternalMessageInfo
 
-func (m *Rounds) GetRounds() []*Round {
+func (m *ListGamesRequest) GetByVariant() bool {
 	if m != nil {
-		return m.Rounds
+		return m.ByVariant
+	}
+	return false
+}
+
+func (m *ListGamesRequest) GetVariant() GameVariant {
+	if m != nil {
+		return m.Variant
+	}
+	return GameVariant_HOLDEM
+}
+
+func (m *ListGamesRequest) GetBigBlind() int64 {
+	if m != nil {
+		return m.BigBlind
+	}
+	return 0
+}
+
+func (m *ListGamesRequest) GetSeatsAvailable() int64 {
+	if m != nil {
+		return m.SeatsAvailable
+	}
+	return 0
+}
+
+func (m *ListGamesRequest) GetByInRound() bool {
+	if m != nil {
+		return m.ByInRound
+	}
+	return false
+}
+
+func (m *ListGamesRequest) GetInRound() bool {
+	if m != nil {
+		return m.InRound
+	}
+	return false
+}
+
+func (m *ListGamesRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListGamesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type GameList struct {
+	Games []*LobbyGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// games matching the filters across every page
+	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameList) Reset()         { *m = GameList{} }
+func (m *GameList) String() string { return proto.CompactTextString(m) }
+func (*GameList) ProtoMessage()    {}
+func (*GameList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{8}
+}
+
+func (m *GameList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameList.Unmarshal(m, b)
+}
+func (m *GameList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameList.Marshal(b, m, deterministic)
+}
+func (m *GameList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameList.Merge(m, src)
+}
+func (m *GameList) XXX_Size() int {
+	return xxx_messageInfo_GameList.Size(m)
+}
+func (m *GameList) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameList proto.InternalMessageInfo
+
+func (m *GameList) GetGames() []*LobbyGame {
+	if m != nil {
+		return m.Games
 	}
 	return nil
 }
 
-type Bet struct {
-	Id                   int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status               RoundStatus `protobuf:"varint,2,opt,name=status,proto3,enum=poker.RoundStatus" json:"status,omitempty"`
-	Round                int64       `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
-	Game                 int64       `protobuf:"varint,4,opt,name=game,proto3" json:"game,omitempty"`
-	Player               int64       `protobuf:"varint,5,opt,name=player,proto3" json:"player,omitempty"`
-	Chips                int64       `protobuf:"varint,6,opt,name=chips,proto3" json:"chips,omitempty"`
-	Type                 Bet_BetType `protobuf:"varint,7,opt,name=type,proto3,enum=poker.Bet_BetType" json:"type,omitempty"`
+func (m *GameList) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// games are pushed whenever their players or status change, filtered the same as ListGamesRequest
+type WatchLobbyRequest struct {
+	ByVariant            bool        `protobuf:"varint,1,opt,name=by_variant,json=byVariant,proto3" json:"by_variant,omitempty"`
+	Variant              GameVariant `protobuf:"varint,2,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	BigBlind             int64       `protobuf:"varint,3,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`
+	SeatsAvailable       int64       `protobuf:"varint,4,opt,name=seats_available,json=seatsAvailable,proto3" json:"seats_available,omitempty"`
+	ByInRound            bool        `protobuf:"varint,5,opt,name=by_in_round,json=byInRound,proto3" json:"by_in_round,omitempty"`
+	InRound              bool        `protobuf:"varint,6,opt,name=in_round,json=inRound,proto3" json:"in_round,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Bet) Reset()         { *m = Bet{} }
-func (m *Bet) String() string { return proto.CompactTextString(m) }
-func (*Bet) ProtoMessage()    {}
-func (*Bet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_818c499f6358623d, []int{7}
+func (m *WatchLobbyRequest) Reset()         { *m = WatchLobbyRequest{} }
+func (m *WatchLobbyRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchLobbyRequest) ProtoMessage()    {}
+func (*WatchLobbyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{9}
 }
 
-func (m *Bet) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Bet.Unmarshal(m, b)
+func (m *WatchLobbyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchLobbyRequest.Unmarshal(m, b)
 }
-func (m *Bet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Bet.Marshal(b, m, deterministic)
+func (m *WatchLobbyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchLobbyRequest.Marshal(b, m, deterministic)
 }
-func (m *Bet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Bet.Merge(m, src)
+func (m *WatchLobbyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchLobbyRequest.Merge(m, src)
 }
-func (m *Bet) XXX_Size() int {
-	return xxx_messageInfo_Bet.Size(m)
+func (m *WatchLobbyRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchLobbyRequest.Size(m)
 }
-func (m *Bet) XXX_DiscardUnknown() {
-	xxx_messageInfo_Bet.DiscardUnknown(m)
+func (m *WatchLobbyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchLobbyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Bet proto.InternalMessageInfo
+var xxx_messageInfo_WatchLobbyRequest proto.InternalMessageInfo
 
-func (m *Bet) GetId() int64 {
+func (m *WatchLobbyRequest) GetByVariant() bool {
+	if m != nil {
+		return m.ByVariant
+	}
+	return false
+}
+
+func (m *WatchLobbyRequest) GetVariant() GameVariant {
+	if m != nil {
+		return m.Variant
+	}
+	return GameVariant_HOLDEM
+}
+
+func (m *WatchLobbyRequest) GetBigBlind() int64 {
+	if m != nil {
+		return m.BigBlind
+	}
+	return 0
+}
+
+func (m *WatchLobbyRequest) GetSeatsAvailable() int64 {
+	if m != nil {
+		return m.SeatsAvailable
+	}
+	return 0
+}
+
+func (m *WatchLobbyRequest) GetByInRound() bool {
+	if m != nil {
+		return m.ByInRound
+	}
+	return false
+}
+
+func (m *WatchLobbyRequest) GetInRound() bool {
+	if m != nil {
+		return m.InRound
+	}
+	return false
+}
+
+// any info here is only relevant within a particular hand
+type Round struct {
+	Id      int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  RoundStatus `protobuf:"varint,2,opt,name=status,proto3,enum=poker.RoundStatus" json:"status,omitempty"`
+	Players *Players    `protobuf:"bytes,3,opt,name=players,proto3" json:"players,omitempty"`
+	Deck    string      `protobuf:"bytes,4,opt,name=deck,proto3" json:"deck,omitempty"`
+	Flop    string      `protobuf:"bytes,5,opt,name=flop,proto3" json:"flop,omitempty"`
+	Turn    string      `protobuf:"bytes,6,opt,name=turn,proto3" json:"turn,omitempty"`
+	River   string      `protobuf:"bytes,7,opt,name=river,proto3" json:"river,omitempty"`
+	Bets    *Bets       `protobuf:"bytes,8,opt,name=bets,proto3" json:"bets,omitempty"`
+	Game    int64       `protobuf:"varint,9,opt,name=game,proto3" json:"game,omitempty"`
+	// Slot of person who has to bet
+	Action        int64  `protobuf:"varint,10,opt,name=action,proto3" json:"action,omitempty"`
+	WinningPlayer int64  `protobuf:"varint,11,opt,name=winning_player,json=winningPlayer,proto3" json:"winning_player,omitempty"`
+	WinningHand   string `protobuf:"bytes,12,opt,name=winning_hand,json=winningHand,proto3" json:"winning_hand,omitempty"`
+	WinningScore  uint32 `protobuf:"varint,13,opt,name=winning_score,json=winningScore,proto3" json:"winning_score,omitempty"`
+	// variant of the game when the round was created
+	Variant GameVariant `protobuf:"varint,14,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	// best qualifying low in hi-lo games, 0 if there was no low
+	WinningLowPlayer int64  `protobuf:"varint,15,opt,name=winning_low_player,json=winningLowPlayer,proto3" json:"winning_low_player,omitempty"`
+	WinningLowScore  uint32 `protobuf:"varint,16,opt,name=winning_low_score,json=winningLowScore,proto3" json:"winning_low_score,omitempty"`
+	// provably fair dealing, the commitment to the server seed is published when the round
+	// is created, before the client seed is accepted, and the seed is only revealed once the round is over
+	Commitment string `protobuf:"bytes,17,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	ServerSeed string `protobuf:"bytes,18,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`
+	// optional, mixed into the shuffle when supplied to StartRound or CreateDeck
+	ClientSeed string `protobuf:"bytes,19,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	// describes the winning high hand, eg. "Full house, Kings full of Sevens"
+	WinningCategory HandCategory `protobuf:"varint,20,opt,name=winning_category,json=winningCategory,proto3,enum=poker.HandCategory" json:"winning_category,omitempty"`
+	WinningHandName string       `protobuf:"bytes,21,opt,name=winning_hand_name,json=winningHandName,proto3" json:"winning_hand_name,omitempty"`
+	// the best 5 cards of the winning hand
+	WinningBestHand string `protobuf:"bytes,22,opt,name=winning_best_hand,json=winningBestHand,proto3" json:"winning_best_hand,omitempty"`
+	// everyone left in the hand was all in so the board was run out without betting
+	AllIn bool `protobuf:"varint,23,opt,name=all_in,json=allIn,proto3" json:"all_in,omitempty"`
+	// every board dealt when players agreed to run the board more than once, the first is flop/river/turn
+	RunBoards []string `protobuf:"bytes,24,rep,name=run_boards,json=runBoards,proto3" json:"run_boards,omitempty"`
+	// equity of each hand at each street of the all in run out
+	Equities []*StreetEquity `protobuf:"bytes,25,rep,name=equities,proto3" json:"equities,omitempty"`
+	// training mode of the game when the round was created
+	Training bool `protobuf:"varint,26,opt,name=training,proto3" json:"training,omitempty"`
+	// in training mode, the outs of each player in hand on the flop and turn
+	Outs []*PlayerOuts `protobuf:"bytes,27,rep,name=outs,proto3" json:"outs,omitempty"`
+	// slot of the dealer button when the round started
+	Dealer               int64    `protobuf:"varint,28,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Round) Reset()         { *m = Round{} }
+func (m *Round) String() string { return proto.CompactTextString(m) }
+func (*Round) ProtoMessage()    {}
+func (*Round) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{10}
+}
+
+func (m *Round) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Round.Unmarshal(m, b)
+}
+func (m *Round) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Round.Marshal(b, m, deterministic)
+}
+func (m *Round) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Round.Merge(m, src)
+}
+func (m *Round) XXX_Size() int {
+	return xxx_messageInfo_Round.Size(m)
+}
+func (m *Round) XXX_DiscardUnknown() {
+	xxx_messageInfo_Round.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Round proto.InternalMessageInfo
+
+func (m *Round) GetId() int64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *Bet) GetStatus() RoundStatus {
-	if m != nil {
-		return m.Status
-	}
-	return RoundStatus_NOT_STARTED
+func (m *Round) GetStatus() RoundStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RoundStatus_NOT_STARTED
+}
+
+func (m *Round) GetPlayers() *Players {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+func (m *Round) GetDeck() string {
+	if m != nil {
+		return m.Deck
+	}
+	return ""
+}
+
+func (m *Round) GetFlop() string {
+	if m != nil {
+		return m.Flop
+	}
+	return ""
+}
+
+func (m *Round) GetTurn() string {
+	if m != nil {
+		return m.Turn
+	}
+	return ""
+}
+
+func (m *Round) GetRiver() string {
+	if m != nil {
+		return m.River
+	}
+	return ""
+}
+
+func (m *Round) GetBets() *Bets {
+	if m != nil {
+		return m.Bets
+	}
+	return nil
+}
+
+func (m *Round) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *Round) GetAction() int64 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *Round) GetWinningPlayer() int64 {
+	if m != nil {
+		return m.WinningPlayer
+	}
+	return 0
+}
+
+func (m *Round) GetWinningHand() string {
+	if m != nil {
+		return m.WinningHand
+	}
+	return ""
+}
+
+func (m *Round) GetWinningScore() uint32 {
+	if m != nil {
+		return m.WinningScore
+	}
+	return 0
+}
+
+func (m *Round) GetVariant() GameVariant {
+	if m != nil {
+		return m.Variant
+	}
+	return GameVariant_HOLDEM
+}
+
+func (m *Round) GetWinningLowPlayer() int64 {
+	if m != nil {
+		return m.WinningLowPlayer
+	}
+	return 0
+}
+
+func (m *Round) GetWinningLowScore() uint32 {
+	if m != nil {
+		return m.WinningLowScore
+	}
+	return 0
+}
+
+func (m *Round) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *Round) GetServerSeed() string {
+	if m != nil {
+		return m.ServerSeed
+	}
+	return ""
+}
+
+func (m *Round) GetClientSeed() string {
+	if m != nil {
+		return m.ClientSeed
+	}
+	return ""
+}
+
+func (m *Round) GetWinningCategory() HandCategory {
+	if m != nil {
+		return m.WinningCategory
+	}
+	return HandCategory_NO_HAND
+}
+
+func (m *Round) GetWinningHandName() string {
+	if m != nil {
+		return m.WinningHandName
+	}
+	return ""
+}
+
+func (m *Round) GetWinningBestHand() string {
+	if m != nil {
+		return m.WinningBestHand
+	}
+	return ""
+}
+
+func (m *Round) GetAllIn() bool {
+	if m != nil {
+		return m.AllIn
+	}
+	return false
+}
+
+func (m *Round) GetRunBoards() []string {
+	if m != nil {
+		return m.RunBoards
+	}
+	return nil
+}
+
+func (m *Round) GetEquities() []*StreetEquity {
+	if m != nil {
+		return m.Equities
+	}
+	return nil
+}
+
+func (m *Round) GetTraining() bool {
+	if m != nil {
+		return m.Training
+	}
+	return false
+}
+
+func (m *Round) GetOuts() []*PlayerOuts {
+	if m != nil {
+		return m.Outs
+	}
+	return nil
+}
+
+func (m *Round) GetDealer() int64 {
+	if m != nil {
+		return m.Dealer
+	}
+	return 0
+}
+
+type PlayerOuts struct {
+	Player int64 `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	// best or tied for the best hand now, so there are no outs
+	Leading bool `protobuf:"varint,2,opt,name=leading,proto3" json:"leading,omitempty"`
+	// cards that make the player the best hand or tied for it on the next card
+	Outs  string `protobuf:"bytes,3,opt,name=outs,proto3" json:"outs,omitempty"`
+	Draws []Draw `protobuf:"varint,4,rep,packed,name=draws,proto3,enum=poker.Draw" json:"draws,omitempty"`
+	// percentage chance of an out coming by the river
+	Probability          float64  `protobuf:"fixed64,5,opt,name=probability,proto3" json:"probability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlayerOuts) Reset()         { *m = PlayerOuts{} }
+func (m *PlayerOuts) String() string { return proto.CompactTextString(m) }
+func (*PlayerOuts) ProtoMessage()    {}
+func (*PlayerOuts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{11}
+}
+
+func (m *PlayerOuts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlayerOuts.Unmarshal(m, b)
+}
+func (m *PlayerOuts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlayerOuts.Marshal(b, m, deterministic)
+}
+func (m *PlayerOuts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerOuts.Merge(m, src)
+}
+func (m *PlayerOuts) XXX_Size() int {
+	return xxx_messageInfo_PlayerOuts.Size(m)
+}
+func (m *PlayerOuts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerOuts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayerOuts proto.InternalMessageInfo
+
+func (m *PlayerOuts) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *PlayerOuts) GetLeading() bool {
+	if m != nil {
+		return m.Leading
+	}
+	return false
+}
+
+func (m *PlayerOuts) GetOuts() string {
+	if m != nil {
+		return m.Outs
+	}
+	return ""
+}
+
+func (m *PlayerOuts) GetDraws() []Draw {
+	if m != nil {
+		return m.Draws
+	}
+	return nil
+}
+
+func (m *PlayerOuts) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+type StreetEquity struct {
+	// 1 for the first board
+	Run                  int32         `protobuf:"varint,1,opt,name=run,proto3" json:"run,omitempty"`
+	Status               RoundStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=poker.RoundStatus" json:"status,omitempty"`
+	Board                string        `protobuf:"bytes,3,opt,name=board,proto3" json:"board,omitempty"`
+	Hands                []*HandEquity `protobuf:"bytes,4,rep,name=hands,proto3" json:"hands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StreetEquity) Reset()         { *m = StreetEquity{} }
+func (m *StreetEquity) String() string { return proto.CompactTextString(m) }
+func (*StreetEquity) ProtoMessage()    {}
+func (*StreetEquity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{12}
+}
+
+func (m *StreetEquity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreetEquity.Unmarshal(m, b)
+}
+func (m *StreetEquity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreetEquity.Marshal(b, m, deterministic)
+}
+func (m *StreetEquity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreetEquity.Merge(m, src)
+}
+func (m *StreetEquity) XXX_Size() int {
+	return xxx_messageInfo_StreetEquity.Size(m)
+}
+func (m *StreetEquity) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreetEquity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreetEquity proto.InternalMessageInfo
+
+func (m *StreetEquity) GetRun() int32 {
+	if m != nil {
+		return m.Run
+	}
+	return 0
+}
+
+func (m *StreetEquity) GetStatus() RoundStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RoundStatus_NOT_STARTED
+}
+
+func (m *StreetEquity) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *StreetEquity) GetHands() []*HandEquity {
+	if m != nil {
+		return m.Hands
+	}
+	return nil
+}
+
+// number of times a player agrees to run the board if everyone is all in,
+// the board is run the fewest times any player in the hand agreed to
+type RunAgreement struct {
+	Round                int64    `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Player               int64    `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	Runs                 int32    `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RunAgreement) Reset()         { *m = RunAgreement{} }
+func (m *RunAgreement) String() string { return proto.CompactTextString(m) }
+func (*RunAgreement) ProtoMessage()    {}
+func (*RunAgreement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{13}
+}
+
+func (m *RunAgreement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunAgreement.Unmarshal(m, b)
+}
+func (m *RunAgreement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunAgreement.Marshal(b, m, deterministic)
+}
+func (m *RunAgreement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunAgreement.Merge(m, src)
+}
+func (m *RunAgreement) XXX_Size() int {
+	return xxx_messageInfo_RunAgreement.Size(m)
+}
+func (m *RunAgreement) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunAgreement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunAgreement proto.InternalMessageInfo
+
+func (m *RunAgreement) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *RunAgreement) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *RunAgreement) GetRuns() int32 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+// result of recomputing a completed round's deck from its revealed seeds
+type Verification struct {
+	Round      int64  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Valid      bool   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	ServerSeed string `protobuf:"bytes,4,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`
+	ClientSeed string `protobuf:"bytes,5,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	// the deck order recomputed from the seeds before any cards were dealt
+	Deck                 string   `protobuf:"bytes,6,opt,name=deck,proto3" json:"deck,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Verification) Reset()         { *m = Verification{} }
+func (m *Verification) String() string { return proto.CompactTextString(m) }
+func (*Verification) ProtoMessage()    {}
+func (*Verification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{14}
+}
+
+func (m *Verification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Verification.Unmarshal(m, b)
+}
+func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Verification.Marshal(b, m, deterministic)
+}
+func (m *Verification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Verification.Merge(m, src)
+}
+func (m *Verification) XXX_Size() int {
+	return xxx_messageInfo_Verification.Size(m)
+}
+func (m *Verification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Verification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Verification proto.InternalMessageInfo
+
+func (m *Verification) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Verification) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *Verification) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *Verification) GetServerSeed() string {
+	if m != nil {
+		return m.ServerSeed
+	}
+	return ""
+}
+
+func (m *Verification) GetClientSeed() string {
+	if m != nil {
+		return m.ClientSeed
+	}
+	return ""
+}
+
+func (m *Verification) GetDeck() string {
+	if m != nil {
+		return m.Deck
+	}
+	return ""
+}
+
+type Rounds struct {
+	Rounds               []*Round `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rounds) Reset()         { *m = Rounds{} }
+func (m *Rounds) String() string { return proto.CompactTextString(m) }
+func (*Rounds) ProtoMessage()    {}
+func (*Rounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{15}
+}
+
+func (m *Rounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rounds.Unmarshal(m, b)
+}
+func (m *Rounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rounds.Marshal(b, m, deterministic)
+}
+func (m *Rounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rounds.Merge(m, src)
+}
+func (m *Rounds) XXX_Size() int {
+	return xxx_messageInfo_Rounds.Size(m)
+}
+func (m *Rounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rounds proto.InternalMessageInfo
+
+func (m *Rounds) GetRounds() []*Round {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+type Bet struct {
+	Id                   int64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               RoundStatus `protobuf:"varint,2,opt,name=status,proto3,enum=poker.RoundStatus" json:"status,omitempty"`
+	Round                int64       `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Game                 int64       `protobuf:"varint,4,opt,name=game,proto3" json:"game,omitempty"`
+	Player               int64       `protobuf:"varint,5,opt,name=player,proto3" json:"player,omitempty"`
+	Chips                int64       `protobuf:"varint,6,opt,name=chips,proto3" json:"chips,omitempty"`
+	Type                 Bet_BetType `protobuf:"varint,7,opt,name=type,proto3,enum=poker.Bet_BetType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Bet) Reset()         { *m = Bet{} }
+func (m *Bet) String() string { return proto.CompactTextString(m) }
+func (*Bet) ProtoMessage()    {}
+func (*Bet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{16}
+}
+
+func (m *Bet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bet.Unmarshal(m, b)
+}
+func (m *Bet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bet.Marshal(b, m, deterministic)
+}
+func (m *Bet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bet.Merge(m, src)
+}
+func (m *Bet) XXX_Size() int {
+	return xxx_messageInfo_Bet.Size(m)
+}
+func (m *Bet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bet proto.InternalMessageInfo
+
+func (m *Bet) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Bet) GetStatus() RoundStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RoundStatus_NOT_STARTED
+}
+
+func (m *Bet) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Bet) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *Bet) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *Bet) GetChips() int64 {
+	if m != nil {
+		return m.Chips
+	}
+	return 0
+}
+
+func (m *Bet) GetType() Bet_BetType {
+	if m != nil {
+		return m.Type
+	}
+	return Bet_NONE
+}
+
+type Bets struct {
+	Bets                 []*Bet   `protobuf:"bytes,1,rep,name=bets,proto3" json:"bets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Bets) Reset()         { *m = Bets{} }
+func (m *Bets) String() string { return proto.CompactTextString(m) }
+func (*Bets) ProtoMessage()    {}
+func (*Bets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{17}
+}
+
+func (m *Bets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bets.Unmarshal(m, b)
+}
+func (m *Bets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bets.Marshal(b, m, deterministic)
+}
+func (m *Bets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bets.Merge(m, src)
+}
+func (m *Bets) XXX_Size() int {
+	return xxx_messageInfo_Bets.Size(m)
+}
+func (m *Bets) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bets proto.InternalMessageInfo
+
+func (m *Bets) GetBets() []*Bet {
+	if m != nil {
+		return m.Bets
+	}
+	return nil
+}
+
+// exports a single completed round, or every completed round of the game when round is unset
+type HandHistoryRequest struct {
+	Game                 int64    `protobuf:"varint,1,opt,name=game,proto3" json:"game,omitempty"`
+	Round                int64    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandHistoryRequest) Reset()         { *m = HandHistoryRequest{} }
+func (m *HandHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HandHistoryRequest) ProtoMessage()    {}
+func (*HandHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{18}
+}
+
+func (m *HandHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandHistoryRequest.Unmarshal(m, b)
+}
+func (m *HandHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *HandHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandHistoryRequest.Merge(m, src)
+}
+func (m *HandHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_HandHistoryRequest.Size(m)
+}
+func (m *HandHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandHistoryRequest proto.InternalMessageInfo
+
+func (m *HandHistoryRequest) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *HandHistoryRequest) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// completed hands in the PokerStars hand history text format
+type HandHistory struct {
+	Rounds               []int64  `protobuf:"varint,1,rep,packed,name=rounds,proto3" json:"rounds,omitempty"`
+	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandHistory) Reset()         { *m = HandHistory{} }
+func (m *HandHistory) String() string { return proto.CompactTextString(m) }
+func (*HandHistory) ProtoMessage()    {}
+func (*HandHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{19}
+}
+
+func (m *HandHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandHistory.Unmarshal(m, b)
+}
+func (m *HandHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandHistory.Marshal(b, m, deterministic)
+}
+func (m *HandHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandHistory.Merge(m, src)
+}
+func (m *HandHistory) XXX_Size() int {
+	return xxx_messageInfo_HandHistory.Size(m)
+}
+func (m *HandHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandHistory proto.InternalMessageInfo
+
+func (m *HandHistory) GetRounds() []int64 {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+func (m *HandHistory) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+// an entry in a round's append only event log
+type HandEvent struct {
+	Id    int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Game  int64 `protobuf:"varint,2,opt,name=game,proto3" json:"game,omitempty"`
+	Round int64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// order of the event in the round, from 1
+	Seq     int64         `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type    HandEventType `protobuf:"varint,5,opt,name=type,proto3,enum=poker.HandEventType" json:"type,omitempty"`
+	Status  RoundStatus   `protobuf:"varint,6,opt,name=status,proto3,enum=poker.RoundStatus" json:"status,omitempty"`
+	Player  int64         `protobuf:"varint,7,opt,name=player,proto3" json:"player,omitempty"`
+	Slot    int64         `protobuf:"varint,8,opt,name=slot,proto3" json:"slot,omitempty"`
+	Chips   int64         `protobuf:"varint,9,opt,name=chips,proto3" json:"chips,omitempty"`
+	Cards   string        `protobuf:"bytes,10,opt,name=cards,proto3" json:"cards,omitempty"`
+	UpCards string        `protobuf:"bytes,11,opt,name=up_cards,json=upCards,proto3" json:"up_cards,omitempty"`
+	Bet     Bet_BetType   `protobuf:"varint,12,opt,name=bet,proto3,enum=poker.Bet_BetType" json:"bet,omitempty"`
+	Deck    string        `protobuf:"bytes,13,opt,name=deck,proto3" json:"deck,omitempty"`
+	// unix time in milliseconds the event was recorded
+	Time int64 `protobuf:"varint,14,opt,name=time,proto3" json:"time,omitempty"`
+	Runs int32 `protobuf:"varint,15,opt,name=runs,proto3" json:"runs,omitempty"`
+	// the game's blinds, limit and rake when the round started, only set on START
+	Settings             *Game    `protobuf:"bytes,16,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandEvent) Reset()         { *m = HandEvent{} }
+func (m *HandEvent) String() string { return proto.CompactTextString(m) }
+func (*HandEvent) ProtoMessage()    {}
+func (*HandEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{20}
+}
+
+func (m *HandEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandEvent.Unmarshal(m, b)
+}
+func (m *HandEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandEvent.Marshal(b, m, deterministic)
+}
+func (m *HandEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandEvent.Merge(m, src)
+}
+func (m *HandEvent) XXX_Size() int {
+	return xxx_messageInfo_HandEvent.Size(m)
+}
+func (m *HandEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandEvent proto.InternalMessageInfo
+
+func (m *HandEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HandEvent) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *HandEvent) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *HandEvent) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *HandEvent) GetType() HandEventType {
+	if m != nil {
+		return m.Type
+	}
+	return HandEventType_START
+}
+
+func (m *HandEvent) GetStatus() RoundStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RoundStatus_NOT_STARTED
+}
+
+func (m *HandEvent) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *HandEvent) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *HandEvent) GetChips() int64 {
+	if m != nil {
+		return m.Chips
+	}
+	return 0
+}
+
+func (m *HandEvent) GetCards() string {
+	if m != nil {
+		return m.Cards
+	}
+	return ""
+}
+
+func (m *HandEvent) GetUpCards() string {
+	if m != nil {
+		return m.UpCards
+	}
+	return ""
+}
+
+func (m *HandEvent) GetBet() Bet_BetType {
+	if m != nil {
+		return m.Bet
+	}
+	return Bet_NONE
+}
+
+func (m *HandEvent) GetDeck() string {
+	if m != nil {
+		return m.Deck
+	}
+	return ""
+}
+
+func (m *HandEvent) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *HandEvent) GetRuns() int32 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+func (m *HandEvent) GetSettings() *Game {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type SpectateRequest struct {
+	Game int64 `protobuf:"varint,1,opt,name=game,proto3" json:"game,omitempty"`
+	// the player spectating. They can't be seated at the game, or join it, and can't
+	// act at it while they're spectating
+	Player int64 `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	// events are sent once they're at least this old, in milliseconds
+	Delay                int64    `protobuf:"varint,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpectateRequest) Reset()         { *m = SpectateRequest{} }
+func (m *SpectateRequest) String() string { return proto.CompactTextString(m) }
+func (*SpectateRequest) ProtoMessage()    {}
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{21}
+}
+
+func (m *SpectateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpectateRequest.Unmarshal(m, b)
+}
+func (m *SpectateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpectateRequest.Marshal(b, m, deterministic)
+}
+func (m *SpectateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpectateRequest.Merge(m, src)
+}
+func (m *SpectateRequest) XXX_Size() int {
+	return xxx_messageInfo_SpectateRequest.Size(m)
+}
+func (m *SpectateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpectateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpectateRequest proto.InternalMessageInfo
+
+func (m *SpectateRequest) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *SpectateRequest) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *SpectateRequest) GetDelay() int64 {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+// the public view of a hand's event, the deck and hole cards are never shown
+type SpectatorEvent struct {
+	Event *HandEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// chips bet in the hand so far
+	Pot                  int64    `protobuf:"varint,2,opt,name=pot,proto3" json:"pot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpectatorEvent) Reset()         { *m = SpectatorEvent{} }
+func (m *SpectatorEvent) String() string { return proto.CompactTextString(m) }
+func (*SpectatorEvent) ProtoMessage()    {}
+func (*SpectatorEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{22}
+}
+
+func (m *SpectatorEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpectatorEvent.Unmarshal(m, b)
+}
+func (m *SpectatorEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpectatorEvent.Marshal(b, m, deterministic)
+}
+func (m *SpectatorEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpectatorEvent.Merge(m, src)
+}
+func (m *SpectatorEvent) XXX_Size() int {
+	return xxx_messageInfo_SpectatorEvent.Size(m)
+}
+func (m *SpectatorEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpectatorEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpectatorEvent proto.InternalMessageInfo
+
+func (m *SpectatorEvent) GetEvent() *HandEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SpectatorEvent) GetPot() int64 {
+	if m != nil {
+		return m.Pot
+	}
+	return 0
+}
+
+type ReplayRequest struct {
+	Round int64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// replay at the pace the hand was played, divided by speed, 0 streams every event straight away
+	Speed                float64  `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayRequest) Reset()         { *m = ReplayRequest{} }
+func (m *ReplayRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayRequest) ProtoMessage()    {}
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{23}
+}
+
+func (m *ReplayRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayRequest.Unmarshal(m, b)
+}
+func (m *ReplayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayRequest.Marshal(b, m, deterministic)
+}
+func (m *ReplayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayRequest.Merge(m, src)
+}
+func (m *ReplayRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplayRequest.Size(m)
+}
+func (m *ReplayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayRequest proto.InternalMessageInfo
+
+func (m *ReplayRequest) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ReplayRequest) GetSpeed() float64 {
+	if m != nil {
+		return m.Speed
+	}
+	return 0
+}
+
+// filters for a player's stats, unset filters match every hand
+type PlayerStatsRequest struct {
+	Player int64 `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	Game   int64 `protobuf:"varint,2,opt,name=game,proto3" json:"game,omitempty"`
+	// hands settled from and before the times, in unix milliseconds
+	From int64 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	// hands played with this big blind
+	BigBlind             int64    `protobuf:"varint,5,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlayerStatsRequest) Reset()         { *m = PlayerStatsRequest{} }
+func (m *PlayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*PlayerStatsRequest) ProtoMessage()    {}
+func (*PlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{24}
+}
+
+func (m *PlayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlayerStatsRequest.Unmarshal(m, b)
+}
+func (m *PlayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlayerStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *PlayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerStatsRequest.Merge(m, src)
+}
+func (m *PlayerStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_PlayerStatsRequest.Size(m)
+}
+func (m *PlayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayerStatsRequest proto.InternalMessageInfo
+
+func (m *PlayerStatsRequest) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *PlayerStatsRequest) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *PlayerStatsRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *PlayerStatsRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *PlayerStatsRequest) GetBigBlind() int64 {
+	if m != nil {
+		return m.BigBlind
+	}
+	return 0
+}
+
+// a player's stats over the hands matching a request, percentages are 0-100
+type PlayerStats struct {
+	Player int64 `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`
+	Hands  int64 `protobuf:"varint,2,opt,name=hands,proto3" json:"hands,omitempty"`
+	// voluntarily put chips in the pot on the first street
+	Vpip float64 `protobuf:"fixed64,3,opt,name=vpip,proto3" json:"vpip,omitempty"`
+	// raised on the first street
+	Pfr float64 `protobuf:"fixed64,4,opt,name=pfr,proto3" json:"pfr,omitempty"`
+	// re-raised of the times the player faced a single first street raise
+	ThreeBet float64 `protobuf:"fixed64,5,opt,name=three_bet,json=threeBet,proto3" json:"three_bet,omitempty"`
+	// bets and raises per call after the first street
+	AggressionFactor float64 `protobuf:"fixed64,6,opt,name=aggression_factor,json=aggressionFactor,proto3" json:"aggression_factor,omitempty"`
+	// went to showdown of the hands the player saw the flop, or fourth street in stud
+	WentToShowdown float64 `protobuf:"fixed64,7,opt,name=went_to_showdown,json=wentToShowdown,proto3" json:"went_to_showdown,omitempty"`
+	// won chips of the hands the player went to showdown
+	WonAtShowdown float64 `protobuf:"fixed64,8,opt,name=won_at_showdown,json=wonAtShowdown,proto3" json:"won_at_showdown,omitempty"`
+	// chips won less chips bet
+	Net                  int64    `protobuf:"varint,9,opt,name=net,proto3" json:"net,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlayerStats) Reset()         { *m = PlayerStats{} }
+func (m *PlayerStats) String() string { return proto.CompactTextString(m) }
+func (*PlayerStats) ProtoMessage()    {}
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{25}
+}
+
+func (m *PlayerStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlayerStats.Unmarshal(m, b)
+}
+func (m *PlayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlayerStats.Marshal(b, m, deterministic)
+}
+func (m *PlayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayerStats.Merge(m, src)
+}
+func (m *PlayerStats) XXX_Size() int {
+	return xxx_messageInfo_PlayerStats.Size(m)
+}
+func (m *PlayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayerStats proto.InternalMessageInfo
+
+func (m *PlayerStats) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetHands() int64 {
+	if m != nil {
+		return m.Hands
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetVpip() float64 {
+	if m != nil {
+		return m.Vpip
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetPfr() float64 {
+	if m != nil {
+		return m.Pfr
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetThreeBet() float64 {
+	if m != nil {
+		return m.ThreeBet
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetAggressionFactor() float64 {
+	if m != nil {
+		return m.AggressionFactor
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetWentToShowdown() float64 {
+	if m != nil {
+		return m.WentToShowdown
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetWonAtShowdown() float64 {
+	if m != nil {
+		return m.WonAtShowdown
+	}
+	return 0
+}
+
+func (m *PlayerStats) GetNet() int64 {
+	if m != nil {
+		return m.Net
+	}
+	return 0
+}
+
+// a window of time leaderboards rank players over
+type Season struct {
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// hands settled from the start and before the end count to the season, in unix milliseconds.
+	// Hands settled before the season was created count as well
+	Start                int64    `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Season) Reset()         { *m = Season{} }
+func (m *Season) String() string { return proto.CompactTextString(m) }
+func (*Season) ProtoMessage()    {}
+func (*Season) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{26}
+}
+
+func (m *Season) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Season.Unmarshal(m, b)
+}
+func (m *Season) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Season.Marshal(b, m, deterministic)
+}
+func (m *Season) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Season.Merge(m, src)
+}
+func (m *Season) XXX_Size() int {
+	return xxx_messageInfo_Season.Size(m)
+}
+func (m *Season) XXX_DiscardUnknown() {
+	xxx_messageInfo_Season.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Season proto.InternalMessageInfo
+
+func (m *Season) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Season) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Season) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Season) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type LeaderboardRequest struct {
+	Season  int64              `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Ranking LeaderboardRanking `protobuf:"varint,2,opt,name=ranking,proto3,enum=poker.LeaderboardRanking" json:"ranking,omitempty"`
+	// rank only the hands of the variant
+	ByVariant bool        `protobuf:"varint,3,opt,name=by_variant,json=byVariant,proto3" json:"by_variant,omitempty"`
+	Variant   GameVariant `protobuf:"varint,4,opt,name=variant,proto3,enum=poker.GameVariant" json:"variant,omitempty"`
+	// pages are numbered from 0, a page size of 0 uses the default
+	Page                 int32    `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize             int32    `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderboardRequest) Reset()         { *m = LeaderboardRequest{} }
+func (m *LeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardRequest) ProtoMessage()    {}
+func (*LeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{27}
+}
+
+func (m *LeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderboardRequest.Unmarshal(m, b)
+}
+func (m *LeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderboardRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardRequest.Merge(m, src)
+}
+func (m *LeaderboardRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaderboardRequest.Size(m)
+}
+func (m *LeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderboardRequest proto.InternalMessageInfo
+
+func (m *LeaderboardRequest) GetSeason() int64 {
+	if m != nil {
+		return m.Season
+	}
+	return 0
+}
+
+func (m *LeaderboardRequest) GetRanking() LeaderboardRanking {
+	if m != nil {
+		return m.Ranking
+	}
+	return LeaderboardRanking_NET
+}
+
+func (m *LeaderboardRequest) GetByVariant() bool {
+	if m != nil {
+		return m.ByVariant
+	}
+	return false
+}
+
+func (m *LeaderboardRequest) GetVariant() GameVariant {
+	if m != nil {
+		return m.Variant
+	}
+	return GameVariant_HOLDEM
+}
+
+func (m *LeaderboardRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *LeaderboardRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type Standing struct {
+	// 1 for the top of the board, tied players are ranked by id
+	Rank                 int64    `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Player               int64    `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Net                  int64    `protobuf:"varint,4,opt,name=net,proto3" json:"net,omitempty"`
+	Hands                int64    `protobuf:"varint,5,opt,name=hands,proto3" json:"hands,omitempty"`
+	Points               int64    `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Standing) Reset()         { *m = Standing{} }
+func (m *Standing) String() string { return proto.CompactTextString(m) }
+func (*Standing) ProtoMessage()    {}
+func (*Standing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{28}
+}
+
+func (m *Standing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Standing.Unmarshal(m, b)
+}
+func (m *Standing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Standing.Marshal(b, m, deterministic)
+}
+func (m *Standing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Standing.Merge(m, src)
+}
+func (m *Standing) XXX_Size() int {
+	return xxx_messageInfo_Standing.Size(m)
+}
+func (m *Standing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Standing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Standing proto.InternalMessageInfo
+
+func (m *Standing) GetRank() int64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *Standing) GetPlayer() int64 {
+	if m != nil {
+		return m.Player
+	}
+	return 0
+}
+
+func (m *Standing) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Standing) GetNet() int64 {
+	if m != nil {
+		return m.Net
+	}
+	return 0
+}
+
+func (m *Standing) GetHands() int64 {
+	if m != nil {
+		return m.Hands
+	}
+	return 0
+}
+
+func (m *Standing) GetPoints() int64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+type Leaderboard struct {
+	Season    int64       `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Standings []*Standing `protobuf:"bytes,2,rep,name=standings,proto3" json:"standings,omitempty"`
+	// players on the board across every page
+	Total                int64    `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Leaderboard) Reset()         { *m = Leaderboard{} }
+func (m *Leaderboard) String() string { return proto.CompactTextString(m) }
+func (*Leaderboard) ProtoMessage()    {}
+func (*Leaderboard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{29}
+}
+
+func (m *Leaderboard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Leaderboard.Unmarshal(m, b)
+}
+func (m *Leaderboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Leaderboard.Marshal(b, m, deterministic)
+}
+func (m *Leaderboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Leaderboard.Merge(m, src)
+}
+func (m *Leaderboard) XXX_Size() int {
+	return xxx_messageInfo_Leaderboard.Size(m)
+}
+func (m *Leaderboard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Leaderboard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Leaderboard proto.InternalMessageInfo
+
+func (m *Leaderboard) GetSeason() int64 {
+	if m != nil {
+		return m.Season
+	}
+	return 0
+}
+
+func (m *Leaderboard) GetStandings() []*Standing {
+	if m != nil {
+		return m.Standings
+	}
+	return nil
+}
+
+func (m *Leaderboard) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// ledger entry of the house cut taken from a single hand
+type Rake struct {
+	Id    int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Game  int64 `protobuf:"varint,2,opt,name=game,proto3" json:"game,omitempty"`
+	Round int64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// size of the pot before the rake was taken
+	Pot                  int64    `protobuf:"varint,4,opt,name=pot,proto3" json:"pot,omitempty"`
+	Chips                int64    `protobuf:"varint,5,opt,name=chips,proto3" json:"chips,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rake) Reset()         { *m = Rake{} }
+func (m *Rake) String() string { return proto.CompactTextString(m) }
+func (*Rake) ProtoMessage()    {}
+func (*Rake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{30}
+}
+
+func (m *Rake) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rake.Unmarshal(m, b)
+}
+func (m *Rake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rake.Marshal(b, m, deterministic)
+}
+func (m *Rake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rake.Merge(m, src)
+}
+func (m *Rake) XXX_Size() int {
+	return xxx_messageInfo_Rake.Size(m)
+}
+func (m *Rake) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rake proto.InternalMessageInfo
+
+func (m *Rake) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Rake) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *Rake) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Rake) GetPot() int64 {
+	if m != nil {
+		return m.Pot
+	}
+	return 0
+}
+
+func (m *Rake) GetChips() int64 {
+	if m != nil {
+		return m.Chips
+	}
+	return 0
+}
+
+type RakeTotal struct {
+	Game int64 `protobuf:"varint,1,opt,name=game,proto3" json:"game,omitempty"`
+	// day formatted as YYYY-MM-DD (UTC)
+	Day   string `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	Chips int64  `protobuf:"varint,3,opt,name=chips,proto3" json:"chips,omitempty"`
+	// hands raked, hands the house took nothing from aren't in the ledger
+	Hands                int64    `protobuf:"varint,4,opt,name=hands,proto3" json:"hands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RakeTotal) Reset()         { *m = RakeTotal{} }
+func (m *RakeTotal) String() string { return proto.CompactTextString(m) }
+func (*RakeTotal) ProtoMessage()    {}
+func (*RakeTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{31}
+}
+
+func (m *RakeTotal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RakeTotal.Unmarshal(m, b)
+}
+func (m *RakeTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RakeTotal.Marshal(b, m, deterministic)
+}
+func (m *RakeTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RakeTotal.Merge(m, src)
+}
+func (m *RakeTotal) XXX_Size() int {
+	return xxx_messageInfo_RakeTotal.Size(m)
+}
+func (m *RakeTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RakeTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RakeTotal proto.InternalMessageInfo
+
+func (m *RakeTotal) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *RakeTotal) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *RakeTotal) GetChips() int64 {
+	if m != nil {
+		return m.Chips
+	}
+	return 0
+}
+
+func (m *RakeTotal) GetHands() int64 {
+	if m != nil {
+		return m.Hands
+	}
+	return 0
+}
+
+// convenience method, not saved in db
+// set game to only report on a single game
+type RakeReport struct {
+	Game                 int64        `protobuf:"varint,1,opt,name=game,proto3" json:"game,omitempty"`
+	Games                []*RakeTotal `protobuf:"bytes,2,rep,name=games,proto3" json:"games,omitempty"`
+	Days                 []*RakeTotal `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	Chips                int64        `protobuf:"varint,4,opt,name=chips,proto3" json:"chips,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RakeReport) Reset()         { *m = RakeReport{} }
+func (m *RakeReport) String() string { return proto.CompactTextString(m) }
+func (*RakeReport) ProtoMessage()    {}
+func (*RakeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{32}
+}
+
+func (m *RakeReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RakeReport.Unmarshal(m, b)
+}
+func (m *RakeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RakeReport.Marshal(b, m, deterministic)
+}
+func (m *RakeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RakeReport.Merge(m, src)
+}
+func (m *RakeReport) XXX_Size() int {
+	return xxx_messageInfo_RakeReport.Size(m)
+}
+func (m *RakeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_RakeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RakeReport proto.InternalMessageInfo
+
+func (m *RakeReport) GetGame() int64 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+func (m *RakeReport) GetGames() []*RakeTotal {
+	if m != nil {
+		return m.Games
+	}
+	return nil
+}
+
+func (m *RakeReport) GetDays() []*RakeTotal {
+	if m != nil {
+		return m.Days
+	}
+	return nil
+}
+
+func (m *RakeReport) GetChips() int64 {
+	if m != nil {
+		return m.Chips
+	}
+	return 0
+}
+
+// hold'em equity of each hand, boards are enumerated when there are few enough otherwise sampled
+type EquityRequest struct {
+	// 2 cards per hand, eg. "AhKh"
+	Hands []string `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"`
+	Board string   `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	// cards that can't come on the board
+	Dead string `protobuf:"bytes,3,opt,name=dead,proto3" json:"dead,omitempty"`
+	// boards to sample when not enumerating, defaults to 100000 and can be at most 1000000
+	Iterations int64 `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// the same seed always gives the same sampled result
+	Seed                 int64    `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EquityRequest) Reset()         { *m = EquityRequest{} }
+func (m *EquityRequest) String() string { return proto.CompactTextString(m) }
+func (*EquityRequest) ProtoMessage()    {}
+func (*EquityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{33}
+}
+
+func (m *EquityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EquityRequest.Unmarshal(m, b)
+}
+func (m *EquityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EquityRequest.Marshal(b, m, deterministic)
+}
+func (m *EquityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquityRequest.Merge(m, src)
+}
+func (m *EquityRequest) XXX_Size() int {
+	return xxx_messageInfo_EquityRequest.Size(m)
+}
+func (m *EquityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquityRequest proto.InternalMessageInfo
+
+func (m *EquityRequest) GetHands() []string {
+	if m != nil {
+		return m.Hands
+	}
+	return nil
+}
+
+func (m *EquityRequest) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *EquityRequest) GetDead() string {
+	if m != nil {
+		return m.Dead
+	}
+	return ""
+}
+
+func (m *EquityRequest) GetIterations() int64 {
+	if m != nil {
+		return m.Iterations
+	}
+	return 0
+}
+
+func (m *EquityRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+// hold'em equity of each range, eg. "QQ+, AKs, A5s-A2s, KQo" with optional weights "AKo:0.5"
+type RangeEquityRequest struct {
+	Ranges []string `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	Board  string   `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	// cards that can't be in a range or come on the board
+	Dead string `protobuf:"bytes,3,opt,name=dead,proto3" json:"dead,omitempty"`
+	// deals to sample when not enumerating, defaults to 100000 and can be at most 1000000
+	Iterations           int64    `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Seed                 int64    `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RangeEquityRequest) Reset()         { *m = RangeEquityRequest{} }
+func (m *RangeEquityRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeEquityRequest) ProtoMessage()    {}
+func (*RangeEquityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{34}
+}
+
+func (m *RangeEquityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeEquityRequest.Unmarshal(m, b)
+}
+func (m *RangeEquityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeEquityRequest.Marshal(b, m, deterministic)
+}
+func (m *RangeEquityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeEquityRequest.Merge(m, src)
+}
+func (m *RangeEquityRequest) XXX_Size() int {
+	return xxx_messageInfo_RangeEquityRequest.Size(m)
+}
+func (m *RangeEquityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeEquityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeEquityRequest proto.InternalMessageInfo
+
+func (m *RangeEquityRequest) GetRanges() []string {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *RangeEquityRequest) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *RangeEquityRequest) GetDead() string {
+	if m != nil {
+		return m.Dead
+	}
+	return ""
+}
+
+func (m *RangeEquityRequest) GetIterations() int64 {
+	if m != nil {
+		return m.Iterations
+	}
+	return 0
+}
+
+func (m *RangeEquityRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+type HandEquity struct {
+	Hand string `protobuf:"bytes,1,opt,name=hand,proto3" json:"hand,omitempty"`
+	// percentages of boards
+	Win                  float64  `protobuf:"fixed64,2,opt,name=win,proto3" json:"win,omitempty"`
+	Tie                  float64  `protobuf:"fixed64,3,opt,name=tie,proto3" json:"tie,omitempty"`
+	Equity               float64  `protobuf:"fixed64,4,opt,name=equity,proto3" json:"equity,omitempty"`
+	Player               int64    `protobuf:"varint,5,opt,name=player,proto3" json:"player,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HandEquity) Reset()         { *m = HandEquity{} }
+func (m *HandEquity) String() string { return proto.CompactTextString(m) }
+func (*HandEquity) ProtoMessage()    {}
+func (*HandEquity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{35}
+}
+
+func (m *HandEquity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandEquity.Unmarshal(m, b)
+}
+func (m *HandEquity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandEquity.Marshal(b, m, deterministic)
+}
+func (m *HandEquity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandEquity.Merge(m, src)
+}
+func (m *HandEquity) XXX_Size() int {
+	return xxx_messageInfo_HandEquity.Size(m)
+}
+func (m *HandEquity) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandEquity.DiscardUnknown(m)
 }
 
-func (m *Bet) GetRound() int64 {
+var xxx_messageInfo_HandEquity proto.InternalMessageInfo
+
+func (m *HandEquity) GetHand() string {
 	if m != nil {
-		return m.Round
+		return m.Hand
 	}
-	return 0
+	return ""
 }
 
-func (m *Bet) GetGame() int64 {
+func (m *HandEquity) GetWin() float64 {
 	if m != nil {
-		return m.Game
+		return m.Win
 	}
 	return 0
 }
 
-func (m *Bet) GetPlayer() int64 {
+func (m *HandEquity) GetTie() float64 {
 	if m != nil {
-		return m.Player
+		return m.Tie
 	}
 	return 0
 }
 
-func (m *Bet) GetChips() int64 {
+func (m *HandEquity) GetEquity() float64 {
 	if m != nil {
-		return m.Chips
+		return m.Equity
 	}
 	return 0
 }
 
-func (m *Bet) GetType() Bet_BetType {
+func (m *HandEquity) GetPlayer() int64 {
 	if m != nil {
-		return m.Type
+		return m.Player
 	}
-	return Bet_NONE
+	return 0
 }
 
-type Bets struct {
-	Bets                 []*Bet   `protobuf:"bytes,1,rep,name=bets,proto3" json:"bets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Equity struct {
+	Hands                []*HandEquity `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"`
+	Boards               int64         `protobuf:"varint,2,opt,name=boards,proto3" json:"boards,omitempty"`
+	Exhaustive           bool          `protobuf:"varint,3,opt,name=exhaustive,proto3" json:"exhaustive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Bets) Reset()         { *m = Bets{} }
-func (m *Bets) String() string { return proto.CompactTextString(m) }
-func (*Bets) ProtoMessage()    {}
-func (*Bets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_818c499f6358623d, []int{8}
+func (m *Equity) Reset()         { *m = Equity{} }
+func (m *Equity) String() string { return proto.CompactTextString(m) }
+func (*Equity) ProtoMessage()    {}
+func (*Equity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_818c499f6358623d, []int{36}
 }
 
-func (m *Bets) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Bets.Unmarshal(m, b)
+func (m *Equity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Equity.Unmarshal(m, b)
 }
-func (m *Bets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Bets.Marshal(b, m, deterministic)
+func (m *Equity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Equity.Marshal(b, m, deterministic)
 }
-func (m *Bets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Bets.Merge(m, src)
+func (m *Equity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Equity.Merge(m, src)
 }
-func (m *Bets) XXX_Size() int {
-	return xxx_messageInfo_Bets.Size(m)
+func (m *Equity) XXX_Size() int {
+	return xxx_messageInfo_Equity.Size(m)
 }
-func (m *Bets) XXX_DiscardUnknown() {
-	xxx_messageInfo_Bets.DiscardUnknown(m)
+func (m *Equity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Equity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Bets proto.InternalMessageInfo
+var xxx_messageInfo_Equity proto.InternalMessageInfo
 
-func (m *Bets) GetBets() []*Bet {
+func (m *Equity) GetHands() []*HandEquity {
 	if m != nil {
-		return m.Bets
+		return m.Hands
 	}
 	return nil
 }
 
+func (m *Equity) GetBoards() int64 {
+	if m != nil {
+		return m.Boards
+	}
+	return 0
+}
+
+func (m *Equity) GetExhaustive() bool {
+	if m != nil {
+		return m.Exhaustive
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("poker.GameVariant", GameVariant_name, GameVariant_value)
+	proto.RegisterEnum("poker.BetLimit", BetLimit_name, BetLimit_value)
+	proto.RegisterEnum("poker.HandCategory", HandCategory_name, HandCategory_value)
+	proto.RegisterEnum("poker.Draw", Draw_name, Draw_value)
 	proto.RegisterEnum("poker.RoundStatus", RoundStatus_name, RoundStatus_value)
+	proto.RegisterEnum("poker.HandEventType", HandEventType_name, HandEventType_value)
+	proto.RegisterEnum("poker.LeaderboardRanking", LeaderboardRanking_name, LeaderboardRanking_value)
 	proto.RegisterEnum("poker.Bet_BetType", Bet_BetType_name, Bet_BetType_value)
 	proto.RegisterType((*AmountToCall)(nil), "poker.AmountToCall")
 	proto.RegisterType((*Player)(nil), "poker.Player")
 	proto.RegisterType((*Players)(nil), "poker.Players")
 	proto.RegisterType((*Game)(nil), "poker.Game")
 	proto.RegisterType((*Games)(nil), "poker.Games")
+	proto.RegisterType((*Waitlist)(nil), "poker.Waitlist")
+	proto.RegisterType((*LobbyGame)(nil), "poker.LobbyGame")
+	proto.RegisterType((*ListGamesRequest)(nil), "poker.ListGamesRequest")
+	proto.RegisterType((*GameList)(nil), "poker.GameList")
+	proto.RegisterType((*WatchLobbyRequest)(nil), "poker.WatchLobbyRequest")
 	proto.RegisterType((*Round)(nil), "poker.Round")
+	proto.RegisterType((*PlayerOuts)(nil), "poker.PlayerOuts")
+	proto.RegisterType((*StreetEquity)(nil), "poker.StreetEquity")
+	proto.RegisterType((*RunAgreement)(nil), "poker.RunAgreement")
+	proto.RegisterType((*Verification)(nil), "poker.Verification")
 	proto.RegisterType((*Rounds)(nil), "poker.Rounds")
 	proto.RegisterType((*Bet)(nil), "poker.Bet")
 	proto.RegisterType((*Bets)(nil), "poker.Bets")
+	proto.RegisterType((*HandHistoryRequest)(nil), "poker.HandHistoryRequest")
+	proto.RegisterType((*HandHistory)(nil), "poker.HandHistory")
+	proto.RegisterType((*HandEvent)(nil), "poker.HandEvent")
+	proto.RegisterType((*SpectateRequest)(nil), "poker.SpectateRequest")
+	proto.RegisterType((*SpectatorEvent)(nil), "poker.SpectatorEvent")
+	proto.RegisterType((*ReplayRequest)(nil), "poker.ReplayRequest")
+	proto.RegisterType((*PlayerStatsRequest)(nil), "poker.PlayerStatsRequest")
+	proto.RegisterType((*PlayerStats)(nil), "poker.PlayerStats")
+	proto.RegisterType((*Season)(nil), "poker.Season")
+	proto.RegisterType((*LeaderboardRequest)(nil), "poker.LeaderboardRequest")
+	proto.RegisterType((*Standing)(nil), "poker.Standing")
+	proto.RegisterType((*Leaderboard)(nil), "poker.Leaderboard")
+	proto.RegisterType((*Rake)(nil), "poker.Rake")
+	proto.RegisterType((*RakeTotal)(nil), "poker.RakeTotal")
+	proto.RegisterType((*RakeReport)(nil), "poker.RakeReport")
+	proto.RegisterType((*EquityRequest)(nil), "poker.EquityRequest")
+	proto.RegisterType((*RangeEquityRequest)(nil), "poker.RangeEquityRequest")
+	proto.RegisterType((*HandEquity)(nil), "poker.HandEquity")
+	proto.RegisterType((*Equity)(nil), "poker.Equity")
 }
 
 func init() { proto.RegisterFile("protobufs/poker.proto", fileDescriptor_818c499f6358623d) }
 
 var fileDescriptor_818c499f6358623d = []byte{
-	// 1281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x97, 0x44, 0x3d, 0x47, 0x8f, 0x30, 0x9b, 0xc7, 0x9f, 0x7f, 0x17, 0x68, 0x1d, 0x36, 0x4e,
-	0x15, 0xa7, 0x91, 0x12, 0xf7, 0x11, 0xb4, 0x3d, 0x14, 0x92, 0x2d, 0x39, 0x02, 0x6c, 0xc9, 0x58,
-	0x2a, 0xe9, 0x51, 0xa0, 0xad, 0x8d, 0x43, 0x98, 0x22, 0x05, 0x72, 0xe5, 0xd6, 0x1f, 0xa1, 0xd7,
-	0x5e, 0xfb, 0x65, 0xfa, 0x91, 0x7a, 0xef, 0xa5, 0xd8, 0xd9, 0xa5, 0x44, 0xc9, 0x32, 0xa5, 0xb6,
-	0x07, 0x02, 0x33, 0x3b, 0x33, 0x3b, 0x8f, 0xdf, 0xec, 0xce, 0x12, 0x1e, 0x4d, 0x03, 0x9f, 0xfb,
-	0xe7, 0xb3, 0x0f, 0x61, 0x73, 0xea, 0x5f, 0xb1, 0xa0, 0x81, 0x3c, 0xc9, 0x21, 0xb3, 0xf3, 0xc9,
-	0xa5, 0xef, 0x5f, 0xba, 0xac, 0x19, 0x29, 0x35, 0xd9, 0x64, 0xca, 0x6f, 0xa4, 0x8e, 0xf9, 0x5b,
-	0x1a, 0x2a, 0xad, 0x89, 0x3f, 0xf3, 0xf8, 0xd0, 0x3f, 0xb4, 0x5d, 0x97, 0xec, 0x41, 0x7e, 0xea,
-	0xda, 0x37, 0x2c, 0x30, 0xd2, 0xbb, 0xe9, 0x7a, 0xf9, 0xa0, 0xda, 0x90, 0x5b, 0x9e, 0xe1, 0x22,
-	0x55, 0x42, 0x62, 0x42, 0x2e, 0xf0, 0x67, 0xde, 0xd8, 0xc8, 0xa0, 0x56, 0x45, 0x69, 0x51, 0xb1,
-	0x46, 0xa5, 0x88, 0x3c, 0x84, 0xdc, 0xc5, 0x47, 0x67, 0x1a, 0x1a, 0xda, 0x6e, 0xba, 0xae, 0x51,
-	0xc9, 0x90, 0x27, 0x50, 0x39, 0x67, 0x9c, 0x3b, 0xde, 0xe5, 0xc8, 0xbf, 0x66, 0x81, 0x91, 0xdd,
-	0x4d, 0xd7, 0x8b, 0xb4, 0xac, 0xd6, 0x06, 0xd7, 0x2c, 0x30, 0x7f, 0x4f, 0x43, 0x5e, 0xfa, 0x23,
-	0x35, 0xc8, 0x38, 0x63, 0x0c, 0x45, 0xa3, 0x19, 0x67, 0x4c, 0x08, 0x64, 0x3d, 0x7b, 0xc2, 0xd0,
-	0x6d, 0x89, 0x22, 0x7d, 0x87, 0x1f, 0x02, 0xd9, 0xd0, 0xf5, 0x39, 0xee, 0xaf, 0x51, 0xa4, 0xc9,
-	0xff, 0xa0, 0xe0, 0x78, 0xa3, 0x8f, 0xb6, 0x37, 0x36, 0x72, 0xe8, 0x36, 0xef, 0x78, 0x6f, 0x6d,
-	0x15, 0xaa, 0x1d, 0x8c, 0x43, 0x23, 0x8f, 0xfb, 0x4a, 0x46, 0xac, 0x86, 0x17, 0x7e, 0xc0, 0x8c,
-	0xc2, 0x6e, 0xba, 0x5e, 0xa5, 0x92, 0x31, 0x0f, 0xa0, 0x20, 0x83, 0x0b, 0xc9, 0x17, 0x50, 0x90,
-	0xf5, 0x08, 0x8d, 0xf4, 0xae, 0x76, 0xbb, 0x5a, 0x91, 0xd4, 0xfc, 0x23, 0x0d, 0xd9, 0x63, 0x11,
-	0x6b, 0x3d, 0x6e, 0x21, 0x2a, 0x57, 0x5b, 0xb2, 0x08, 0xe7, 0x26, 0x6b, 0x33, 0x95, 0xd5, 0xd0,
-	0xe6, 0xd5, 0x78, 0x0c, 0xf9, 0x31, 0xb3, 0x5d, 0x55, 0x45, 0x8d, 0x2a, 0x8e, 0xe8, 0xa0, 0x4d,
-	0x1c, 0x0f, 0x73, 0xd4, 0xa8, 0x20, 0x05, 0xac, 0x08, 0x8a, 0xcc, 0x70, 0x11, 0x28, 0x02, 0x16,
-	0x52, 0x25, 0x24, 0xff, 0x87, 0xa2, 0xe3, 0x8d, 0x24, 0xb2, 0x05, 0xac, 0x50, 0xc1, 0xf1, 0x50,
-	0xc7, 0xdc, 0x87, 0x9c, 0xc8, 0x40, 0x00, 0x98, 0xbb, 0x14, 0x84, 0x4a, 0xb9, 0xac, 0x76, 0x12,
-	0x42, 0x2a, 0x25, 0xe6, 0x5f, 0x19, 0xc8, 0xa1, 0xd5, 0x2d, 0xfc, 0xf6, 0x21, 0x1f, 0x72, 0x9b,
-	0xcf, 0x42, 0xcc, 0xab, 0x76, 0x40, 0xe2, 0x71, 0x58, 0x28, 0xa1, 0x4a, 0x23, 0x5e, 0x2b, 0x6d,
-	0x63, 0xad, 0xc6, 0xec, 0xe2, 0x0a, 0xab, 0x50, 0xa2, 0x48, 0x8b, 0xb5, 0x0f, 0xae, 0x3f, 0xc5,
-	0x22, 0x94, 0x28, 0xd2, 0x62, 0x8d, 0xcf, 0x02, 0x4f, 0xa1, 0x8c, 0xb4, 0x00, 0x39, 0x70, 0x44,
-	0x23, 0x16, 0x24, 0xf4, 0xc8, 0x90, 0xcf, 0x20, 0x7b, 0xce, 0x78, 0x68, 0x14, 0xd1, 0x71, 0x94,
-	0x63, 0x9b, 0xf1, 0x90, 0xa2, 0x40, 0x6c, 0x25, 0x72, 0x35, 0x4a, 0xb2, 0xbd, 0x04, 0x2d, 0xe0,
-	0xb0, 0x2f, 0xb8, 0xe3, 0x7b, 0x06, 0x48, 0x38, 0x24, 0x47, 0xf6, 0xa0, 0xf6, 0xb3, 0xe3, 0x79,
-	0xa2, 0xe5, 0xd5, 0xd9, 0x2a, 0xa3, 0xbc, 0xaa, 0x56, 0x55, 0xaf, 0x3f, 0x81, 0x4a, 0xa4, 0x86,
-	0x2d, 0x5a, 0xc1, 0x80, 0xca, 0x6a, 0x0d, 0xfb, 0xf4, 0x73, 0x88, 0x6c, 0x46, 0xb2, 0x33, 0xab,
-	0xd8, 0x99, 0x91, 0x9d, 0x85, 0x0d, 0xda, 0x80, 0xbc, 0x84, 0x95, 0x3c, 0x9d, 0xa3, 0x2e, 0xb1,
-	0x5a, 0x3e, 0xa6, 0x4a, 0x66, 0xfe, 0x9a, 0x01, 0xad, 0xcd, 0xf8, 0x7f, 0xc2, 0xea, 0x61, 0x74,
-	0x1f, 0xa8, 0x33, 0x28, 0x6f, 0x80, 0xa8, 0x48, 0xd9, 0xe5, 0x22, 0xa9, 0x22, 0xc8, 0xf6, 0x8c,
-	0x6e, 0x94, 0xf9, 0x29, 0xce, 0xc7, 0x4f, 0xf1, 0x33, 0xc8, 0xf2, 0x9b, 0xa9, 0x3c, 0x81, 0x8b,
-	0x08, 0xda, 0x8c, 0x8b, 0x6f, 0x78, 0x33, 0x65, 0x14, 0xe5, 0x66, 0x17, 0x0a, 0x6a, 0x81, 0x14,
-	0x21, 0xdb, 0x1f, 0xf4, 0x3b, 0x7a, 0x4a, 0x50, 0xdd, 0xc1, 0xc9, 0x91, 0x9e, 0x16, 0xd4, 0x61,
-	0xeb, 0xe4, 0x44, 0xcf, 0x90, 0x12, 0xe4, 0x68, 0xab, 0x67, 0x75, 0x74, 0x4d, 0x90, 0xd6, 0xa9,
-	0x58, 0xcd, 0x92, 0x02, 0x68, 0xed, 0xde, 0xb1, 0x9e, 0x33, 0x9f, 0x41, 0x56, 0x80, 0x4c, 0x3e,
-	0x55, 0xf8, 0xcb, 0xba, 0xc1, 0xc2, 0xaf, 0x84, 0x7f, 0x7f, 0x04, 0xe5, 0x58, 0x19, 0xc8, 0x3d,
-	0x28, 0xf7, 0x07, 0xc3, 0x91, 0x35, 0x6c, 0xd1, 0x61, 0xe7, 0x48, 0x4f, 0x91, 0x0a, 0x14, 0xcf,
-	0x68, 0x67, 0xd4, 0x3d, 0x19, 0x9c, 0x49, 0xf7, 0x48, 0x49, 0xf7, 0xbd, 0xf7, 0x1d, 0xaa, 0x6b,
-	0x62, 0x71, 0xf8, 0x8e, 0xf6, 0xf5, 0xac, 0xa0, 0xac, 0xb7, 0x83, 0x9f, 0xf4, 0x9c, 0xa0, 0x06,
-	0x42, 0x9a, 0x3f, 0xf8, 0x93, 0x40, 0xee, 0x4c, 0x38, 0x25, 0x0d, 0xa8, 0x1c, 0x06, 0xcc, 0xe6,
-	0x4c, 0xb5, 0xc9, 0xf2, 0x1d, 0xb3, 0xb3, 0xcc, 0x9a, 0x29, 0xf2, 0x1a, 0xaa, 0x71, 0xfd, 0x90,
-	0xac, 0x1c, 0x9b, 0x9d, 0x15, 0xde, 0x4c, 0x91, 0xef, 0xa0, 0x7a, 0xc4, 0x5c, 0x76, 0xb7, 0xc9,
-	0xe3, 0x86, 0x1c, 0x22, 0x8d, 0x68, 0x88, 0x34, 0x3a, 0x62, 0x88, 0x98, 0x29, 0xf2, 0x02, 0x4a,
-	0xc7, 0x8c, 0x6f, 0x19, 0xda, 0xd7, 0xa0, 0xcf, 0x95, 0xc3, 0xf6, 0x4d, 0x1f, 0xef, 0xb4, 0x8d,
-	0xd1, 0x7d, 0x0b, 0xe4, 0xdd, 0x74, 0xbc, 0x48, 0xe8, 0x10, 0x3b, 0xe3, 0x5f, 0xd8, 0xe1, 0xa5,
-	0xbe, 0xd9, 0xae, 0x09, 0x55, 0x2b, 0x8a, 0xd2, 0x12, 0x63, 0x63, 0x53, 0x5a, 0x75, 0x00, 0x59,
-	0x71, 0xbc, 0xe2, 0xe3, 0x17, 0xe2, 0x4e, 0x9c, 0xc1, 0x6a, 0x55, 0x8f, 0x19, 0x17, 0x8c, 0xca,
-	0x3e, 0x49, 0x79, 0x0f, 0x0a, 0x4a, 0x39, 0x51, 0xed, 0x1b, 0x28, 0x4b, 0xf0, 0xe4, 0xf5, 0x5c,
-	0x89, 0x49, 0x93, 0x80, 0x6b, 0xc2, 0xfd, 0x96, 0xeb, 0xfa, 0x17, 0x2a, 0x6c, 0x91, 0x68, 0x98,
-	0xe8, 0xe7, 0x15, 0x10, 0x8b, 0xf1, 0xf6, 0x8c, 0x73, 0xdf, 0x3b, 0xf3, 0x43, 0x47, 0x5c, 0x6d,
-	0xc9, 0x16, 0x4f, 0x21, 0x6f, 0x31, 0x7e, 0xea, 0x78, 0x89, 0x5a, 0x2f, 0xe1, 0xde, 0x7b, 0xdb,
-	0x75, 0x10, 0xa8, 0x60, 0x73, 0x09, 0xeb, 0x00, 0x7d, 0xf6, 0x0b, 0x3f, 0x92, 0x93, 0x2e, 0x49,
-	0xb3, 0x09, 0xf7, 0x25, 0xfe, 0x82, 0xef, 0xc9, 0x31, 0x96, 0x68, 0xd0, 0x00, 0x7d, 0x61, 0xa0,
-	0x4e, 0x76, 0x92, 0xfe, 0x1b, 0x78, 0xac, 0x00, 0x9a, 0xb7, 0x34, 0xba, 0x5a, 0xf1, 0xb2, 0xae,
-	0xc3, 0x6a, 0xd6, 0x92, 0xe1, 0x26, 0x83, 0x1f, 0xe1, 0x21, 0x65, 0x13, 0xff, 0x5a, 0xe9, 0x77,
-	0x03, 0x7f, 0x82, 0x85, 0x5a, 0xe9, 0xcc, 0xbb, 0xd1, 0xfe, 0x1e, 0x8c, 0x63, 0xc6, 0xb1, 0x04,
-	0xf3, 0x58, 0x91, 0xeb, 0x8d, 0xc9, 0xd2, 0x54, 0x58, 0xe3, 0xfc, 0x00, 0x88, 0x6c, 0xef, 0xb8,
-	0xf9, 0x8a, 0xd5, 0x12, 0x87, 0x36, 0x0f, 0x62, 0x36, 0xf3, 0x78, 0x97, 0xd2, 0x5c, 0xb5, 0xa9,
-	0x43, 0x31, 0x8a, 0x71, 0xc3, 0xee, 0xaf, 0x40, 0x8f, 0xb5, 0xcc, 0x36, 0x16, 0xfb, 0x00, 0x16,
-	0xb7, 0x83, 0xad, 0x76, 0x7f, 0x0e, 0x25, 0xd1, 0x5d, 0xf2, 0xba, 0xd8, 0xb8, 0xad, 0xec, 0x98,
-	0x23, 0xf1, 0xe4, 0x48, 0xd6, 0xad, 0x43, 0x51, 0x6c, 0xdb, 0x15, 0x0f, 0x91, 0xad, 0x02, 0xa0,
-	0xf8, 0x12, 0xd9, 0x6a, 0xd3, 0xa1, 0x78, 0xc9, 0x6c, 0x0c, 0x55, 0x22, 0xb2, 0x45, 0xa8, 0xcf,
-	0xa1, 0x64, 0x31, 0xde, 0x92, 0xaf, 0x97, 0x64, 0xd5, 0xd7, 0xd1, 0x21, 0x8b, 0x8f, 0xc3, 0x64,
-	0x93, 0x2f, 0xa1, 0x62, 0x31, 0x2e, 0x0e, 0xf1, 0xc0, 0x13, 0xef, 0x8e, 0x6d, 0xb5, 0xb7, 0xc1,
-	0xae, 0x09, 0xf7, 0x62, 0xe1, 0x6c, 0x51, 0xeb, 0x57, 0xd1, 0x99, 0x97, 0x6f, 0xa2, 0x2d, 0x4a,
-	0xbe, 0xec, 0x62, 0x8b, 0xca, 0xbf, 0x80, 0x4a, 0xd4, 0xd7, 0xf8, 0xb6, 0x58, 0xd6, 0x8e, 0xbf,
-	0x2d, 0x71, 0x44, 0x3e, 0x8a, 0x2b, 0x77, 0xfd, 0x60, 0x6d, 0x4d, 0x57, 0xac, 0xf6, 0xa0, 0x70,
-	0x6a, 0x5f, 0x31, 0x51, 0xcd, 0xd8, 0x5b, 0xe5, 0x56, 0x24, 0x2f, 0xa1, 0xda, 0xb9, 0xb6, 0xdd,
-	0x99, 0xcd, 0x99, 0x78, 0x4e, 0x86, 0x1b, 0x33, 0xad, 0xcd, 0xc7, 0xf5, 0x3a, 0xa8, 0x6e, 0x0d,
-	0xc2, 0x37, 0xf0, 0x28, 0x3e, 0x71, 0xfb, 0x3e, 0x57, 0xff, 0x57, 0x9b, 0x26, 0x68, 0x17, 0xaf,
-	0xa7, 0xf8, 0x8f, 0x68, 0xd7, 0x0f, 0xd4, 0xa3, 0xe2, 0x81, 0x52, 0x8e, 0x4b, 0x77, 0xd6, 0x2d,
-	0x9a, 0x29, 0xf2, 0x03, 0x54, 0x7b, 0x61, 0x7b, 0xf1, 0x2b, 0xf9, 0x4f, 0x8c, 0xcf, 0xf3, 0x78,
-	0x6b, 0x7e, 0xf5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xd3, 0xd3, 0xd2, 0x4f, 0x0f, 0x00,
-	0x00,
+	// 3720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x5d, 0x73, 0xdb, 0x48,
+	0x72, 0x02, 0xc1, 0xcf, 0xa6, 0x28, 0x41, 0x63, 0xd9, 0xa6, 0xb9, 0x9b, 0x3d, 0x2f, 0xb2, 0xb6,
+	0x75, 0xda, 0x5d, 0x7b, 0xcf, 0x7b, 0xc9, 0xde, 0x47, 0x72, 0x29, 0x4a, 0xa4, 0x44, 0x66, 0x65,
+	0x52, 0x05, 0x52, 0x76, 0xea, 0xaa, 0x52, 0x28, 0x48, 0x1c, 0xcb, 0x88, 0x29, 0x80, 0x0b, 0x0c,
+	0x25, 0xeb, 0xde, 0x52, 0x97, 0xa7, 0xcd, 0x43, 0x2a, 0x79, 0xb9, 0x1f, 0x71, 0x79, 0xc8, 0x0f,
+	0x48, 0x55, 0x1e, 0xf3, 0x33, 0x52, 0x79, 0xc9, 0xef, 0x48, 0x75, 0xcf, 0x00, 0x18, 0x50, 0x12,
+	0xa5, 0xcd, 0x55, 0x5e, 0xee, 0x41, 0xa5, 0xe9, 0x8f, 0x99, 0xe9, 0xe9, 0xee, 0xe9, 0xe9, 0x6e,
+	0x10, 0xee, 0xcf, 0xa2, 0x50, 0x84, 0xc7, 0xf3, 0xb7, 0xf1, 0x8b, 0x59, 0xf8, 0x9e, 0x47, 0xcf,
+	0x09, 0x66, 0x25, 0x02, 0x5a, 0x1f, 0x9d, 0x86, 0xe1, 0xe9, 0x94, 0xbf, 0x48, 0x98, 0x5e, 0xf0,
+	0xb3, 0x99, 0xb8, 0x94, 0x3c, 0xf6, 0xbf, 0x18, 0xb0, 0xda, 0x3e, 0x0b, 0xe7, 0x81, 0x18, 0x87,
+	0xbb, 0xde, 0x74, 0xca, 0x9e, 0x40, 0x79, 0x36, 0xf5, 0x2e, 0x79, 0xd4, 0x34, 0x1e, 0x1b, 0x5b,
+	0xf5, 0x97, 0x8d, 0xe7, 0x72, 0xc9, 0x43, 0x42, 0x3a, 0x8a, 0xc8, 0x6c, 0x28, 0x45, 0xe1, 0x3c,
+	0x98, 0x34, 0x0b, 0xc4, 0xb5, 0xaa, 0xb8, 0x1c, 0xc4, 0x39, 0x92, 0xc4, 0x36, 0xa1, 0x74, 0xf2,
+	0xce, 0x9f, 0xc5, 0x4d, 0xf3, 0xb1, 0xb1, 0x65, 0x3a, 0x12, 0x60, 0x9f, 0xc2, 0xea, 0x31, 0x17,
+	0xc2, 0x0f, 0x4e, 0xdd, 0xf0, 0x9c, 0x47, 0xcd, 0xe2, 0x63, 0x63, 0xab, 0xea, 0xd4, 0x15, 0x6e,
+	0x78, 0xce, 0x23, 0xfb, 0x3f, 0x0b, 0x50, 0x96, 0xfb, 0xb1, 0x35, 0x28, 0xf8, 0x13, 0x12, 0xc5,
+	0x74, 0x0a, 0xfe, 0x84, 0x31, 0x28, 0x06, 0xde, 0x19, 0xa7, 0x6d, 0x6b, 0x0e, 0x8d, 0x6f, 0xd8,
+	0x87, 0x41, 0x31, 0x9e, 0x86, 0x82, 0xd6, 0x37, 0x1d, 0x1a, 0xb3, 0x87, 0x50, 0xf1, 0x03, 0xf7,
+	0x9d, 0x17, 0x4c, 0x9a, 0x25, 0xda, 0xb6, 0xec, 0x07, 0x3d, 0x4f, 0x89, 0xea, 0x45, 0x93, 0xb8,
+	0x59, 0xa6, 0x75, 0x25, 0x80, 0xd8, 0xf8, 0x24, 0x8c, 0x78, 0xb3, 0xf2, 0xd8, 0xd8, 0x6a, 0x38,
+	0x12, 0x60, 0x1f, 0x41, 0x6d, 0x1a, 0x5e, 0xb8, 0x92, 0x52, 0x25, 0x4a, 0x75, 0x1a, 0x5e, 0x8c,
+	0x88, 0xf8, 0x08, 0xaa, 0xf3, 0x99, 0x2b, 0xd7, 0xaa, 0xd1, 0x5a, 0x95, 0xf9, 0x6c, 0x97, 0x56,
+	0xfb, 0x19, 0x34, 0x70, 0x67, 0xf7, 0xc4, 0x13, 0xfc, 0x34, 0x8c, 0x2e, 0x9b, 0xf0, 0xd8, 0xd8,
+	0x5a, 0x7b, 0x79, 0x4f, 0xa9, 0x0e, 0xe5, 0xd8, 0x55, 0x24, 0x67, 0xf5, 0x9d, 0x06, 0xe1, 0x8e,
+	0x34, 0x93, 0x4e, 0x5e, 0xa7, 0x55, 0xab, 0x88, 0x18, 0xe0, 0xe9, 0x3f, 0x82, 0xda, 0x31, 0x8f,
+	0x85, 0x3c, 0xd5, 0xaa, 0x24, 0x22, 0x02, 0xd7, 0xb3, 0x5f, 0x42, 0x45, 0x2a, 0x32, 0x66, 0xcf,
+	0xa0, 0x22, 0x6d, 0x17, 0x37, 0x8d, 0xc7, 0xe6, 0x55, 0xcb, 0x26, 0x54, 0xfb, 0xfb, 0x22, 0x14,
+	0xf7, 0x71, 0xe5, 0x2d, 0x7d, 0x06, 0x5a, 0x79, 0x2d, 0x37, 0x23, 0x4e, 0xa7, 0x5c, 0x6b, 0x15,
+	0x69, 0x39, 0x33, 0xb5, 0xdc, 0x03, 0x28, 0x4f, 0xb8, 0x37, 0x55, 0x16, 0x37, 0x1d, 0x05, 0x31,
+	0x0b, 0xcc, 0x33, 0x3f, 0x20, 0x7b, 0x98, 0x0e, 0x0e, 0xd1, 0x05, 0xc9, 0x81, 0xa4, 0x35, 0x32,
+	0x41, 0xc9, 0xb9, 0x62, 0x47, 0x11, 0x51, 0xd5, 0x7e, 0xe0, 0x12, 0x40, 0x06, 0xaa, 0x3a, 0x15,
+	0x3f, 0x20, 0x1e, 0xf6, 0x23, 0xa8, 0xc7, 0x67, 0xde, 0x74, 0xea, 0x1e, 0x4f, 0xfd, 0x60, 0x42,
+	0x46, 0x32, 0x1d, 0x20, 0xd4, 0x0e, 0x62, 0x48, 0x69, 0xfe, 0xa9, 0x22, 0xd7, 0x88, 0x5c, 0x3d,
+	0xf6, 0x4f, 0x25, 0xf1, 0x21, 0x54, 0xce, 0xfc, 0xc0, 0x3d, 0xe6, 0x82, 0x4c, 0x64, 0x3a, 0xe5,
+	0x33, 0x3f, 0xd8, 0xe1, 0x02, 0x5d, 0x37, 0xf2, 0xde, 0x73, 0x77, 0xc6, 0xa3, 0x13, 0x1e, 0x08,
+	0x32, 0x85, 0xe1, 0xd4, 0x11, 0x77, 0x28, 0x51, 0x28, 0x14, 0xb1, 0x9c, 0x78, 0x33, 0x32, 0x86,
+	0xe9, 0x54, 0x10, 0xde, 0xf5, 0x66, 0xec, 0x09, 0xac, 0x07, 0xa1, 0xfb, 0x76, 0x1a, 0xce, 0xdc,
+	0x20, 0x74, 0x27, 0x51, 0x38, 0x6b, 0x36, 0x48, 0xec, 0xd5, 0x20, 0xdc, 0x9b, 0x86, 0xb3, 0x41,
+	0xd8, 0x89, 0xc2, 0x19, 0xfb, 0x02, 0x2a, 0xe7, 0x5e, 0xe4, 0x7b, 0x81, 0x68, 0xae, 0x91, 0x83,
+	0x30, 0x75, 0x7c, 0xb4, 0xc9, 0x6b, 0x49, 0x71, 0x12, 0x16, 0xf6, 0x04, 0x4a, 0x53, 0xff, 0xcc,
+	0x17, 0xcd, 0x75, 0xe2, 0x5d, 0x57, 0xbc, 0x3b, 0x5c, 0x1c, 0x20, 0xda, 0x91, 0x54, 0xd6, 0x82,
+	0xaa, 0x88, 0x3c, 0x3f, 0xf0, 0x83, 0xd3, 0xa6, 0x45, 0x9b, 0xa6, 0x30, 0xea, 0xe2, 0xcc, 0xfb,
+	0xe0, 0xc6, 0xdc, 0x13, 0x71, 0x73, 0x43, 0xea, 0xe2, 0xcc, 0xfb, 0x30, 0x42, 0xd8, 0xde, 0x86,
+	0x12, 0xee, 0x8b, 0xd7, 0xb6, 0x74, 0x8a, 0x03, 0xe5, 0x3c, 0x75, 0x4d, 0x28, 0x47, 0x52, 0xec,
+	0x1e, 0x54, 0xdf, 0x78, 0xbe, 0x98, 0xfa, 0xb1, 0x40, 0x8f, 0x40, 0xa4, 0xba, 0xb9, 0xc5, 0xd3,
+	0x05, 0x7f, 0x2a, 0x2c, 0xf5, 0x27, 0xfb, 0xf7, 0x05, 0xa8, 0x1d, 0x84, 0xc7, 0xc7, 0x97, 0xfb,
+	0x99, 0x27, 0x2d, 0x8f, 0x01, 0x9a, 0xd6, 0xcc, 0x1f, 0xa0, 0xb5, 0xe2, 0x52, 0xad, 0x2d, 0xb8,
+	0x51, 0x69, 0xb9, 0x1b, 0x95, 0x17, 0xdc, 0xa8, 0x99, 0x1d, 0xb7, 0x22, 0x3d, 0x41, 0x81, 0x14,
+	0x57, 0x48, 0xdb, 0xd2, 0x31, 0x25, 0x90, 0xf3, 0xe7, 0x5a, 0xde, 0x9f, 0x3f, 0x86, 0xda, 0x3c,
+	0x38, 0xf3, 0xc4, 0xc9, 0x3b, 0x3e, 0x21, 0x9f, 0xac, 0x3a, 0x19, 0xc2, 0xfe, 0xe7, 0x02, 0x58,
+	0x07, 0x7e, 0x2c, 0xc8, 0x50, 0x0e, 0xff, 0x6e, 0xce, 0x63, 0xc1, 0xfe, 0x04, 0xe0, 0xf8, 0xd2,
+	0x4d, 0x74, 0x62, 0xc8, 0x39, 0xc7, 0x97, 0x4a, 0x15, 0xba, 0xbe, 0x0a, 0xb7, 0xeb, 0x2b, 0x77,
+	0x4e, 0x73, 0xe1, 0x9c, 0xcf, 0x60, 0x9d, 0x0e, 0xe0, 0x7a, 0xe7, 0x9e, 0x3f, 0xf5, 0x8e, 0xa7,
+	0x5c, 0xdd, 0xf0, 0x35, 0x42, 0xb7, 0x13, 0x2c, 0xfb, 0x04, 0xea, 0xc7, 0x97, 0x6e, 0x7a, 0xc6,
+	0x52, 0x22, 0x53, 0x5f, 0x9d, 0x52, 0x57, 0x40, 0x39, 0xaf, 0x00, 0x06, 0xc5, 0x99, 0x77, 0x2a,
+	0x03, 0x71, 0xc9, 0xa1, 0x31, 0x0a, 0x85, 0xff, 0xdd, 0xd8, 0xff, 0x8d, 0x8c, 0xc3, 0x25, 0xa7,
+	0x8a, 0x88, 0x91, 0xff, 0x1b, 0x8e, 0xbe, 0x88, 0x27, 0x41, 0xb5, 0xb0, 0xa7, 0x79, 0xd7, 0xb5,
+	0xd4, 0x49, 0x53, 0x07, 0x53, 0xfe, 0x8b, 0x66, 0x11, 0xa1, 0xf0, 0xa6, 0xa4, 0x11, 0xd3, 0x91,
+	0x80, 0xfd, 0xdf, 0x06, 0x6c, 0xbc, 0x41, 0x4d, 0x13, 0xff, 0x1f, 0xa5, 0x7a, 0xed, 0xff, 0xa8,
+	0x40, 0x49, 0x32, 0x2d, 0xde, 0xb5, 0x6d, 0x28, 0xc7, 0xc2, 0x13, 0xf3, 0x78, 0xe1, 0x1c, 0xc4,
+	0x3d, 0x22, 0x8a, 0xa3, 0x38, 0xf4, 0xfb, 0x6d, 0xde, 0xfa, 0x5e, 0x4c, 0xf8, 0xc9, 0x7b, 0x3a,
+	0x48, 0xcd, 0xa1, 0x31, 0xe2, 0x30, 0x36, 0x92, 0xdc, 0x35, 0x87, 0xc6, 0x88, 0x13, 0xf3, 0x28,
+	0x50, 0xaf, 0x32, 0x8d, 0xd1, 0x4a, 0x91, 0x8f, 0x89, 0x43, 0x85, 0x90, 0x12, 0x60, 0x3f, 0x82,
+	0xe2, 0x31, 0x57, 0x37, 0x2a, 0x8b, 0x4e, 0x3b, 0x5c, 0xc4, 0x0e, 0x11, 0xd2, 0x80, 0x54, 0xd3,
+	0x02, 0xd2, 0x03, 0x28, 0x7b, 0x27, 0xc2, 0x0f, 0x83, 0x24, 0xce, 0x4b, 0x88, 0x3d, 0x81, 0xb5,
+	0x0b, 0x3f, 0xc0, 0xe0, 0xe8, 0xaa, 0x5c, 0xa8, 0x4e, 0xf4, 0x86, 0xc2, 0xaa, 0xdc, 0xe4, 0x53,
+	0x58, 0x4d, 0xd8, 0xb4, 0xc7, 0xb7, 0xae, 0x70, 0x94, 0x57, 0xfc, 0x29, 0x24, 0x73, 0x54, 0xbe,
+	0xd0, 0xa0, 0x7c, 0x21, 0x99, 0x27, 0x73, 0x86, 0x1f, 0x16, 0xf1, 0xbf, 0x00, 0x96, 0x2c, 0x89,
+	0x69, 0x88, 0x12, 0x70, 0x9d, 0x04, 0xb4, 0x14, 0xe5, 0x20, 0xbc, 0x50, 0x32, 0x6e, 0xc3, 0x86,
+	0xce, 0x2d, 0x85, 0xb0, 0x48, 0x88, 0xf5, 0x8c, 0x59, 0xca, 0xf1, 0x09, 0xc0, 0x49, 0x78, 0x76,
+	0xe6, 0x8b, 0x33, 0x7c, 0xdc, 0x36, 0xe8, 0x34, 0x1a, 0x86, 0xc2, 0x21, 0x8f, 0xce, 0x79, 0xe4,
+	0xc6, 0x9c, 0x4f, 0x9a, 0x4c, 0x32, 0x48, 0xd4, 0x88, 0x73, 0x7a, 0x76, 0x4f, 0xa6, 0x3e, 0x0f,
+	0x84, 0x64, 0xb8, 0xa7, 0x56, 0x20, 0x14, 0x31, 0xfc, 0x0a, 0x12, 0x09, 0xb3, 0x2c, 0x68, 0xf3,
+	0xe6, 0x2c, 0x28, 0x91, 0x30, 0x41, 0xe8, 0xa7, 0xc9, 0x12, 0xa2, 0xfb, 0xb4, 0xcd, 0xba, 0xa6,
+	0x76, 0xca, 0x8b, 0x34, 0xde, 0x2c, 0x3f, 0x7a, 0x90, 0xe3, 0xdd, 0x51, 0x69, 0x12, 0xbb, 0x0f,
+	0x65, 0x0c, 0xf3, 0x7e, 0xd0, 0x7c, 0x48, 0x17, 0xa3, 0xe4, 0x4d, 0xa7, 0xfd, 0x00, 0x2f, 0x79,
+	0x34, 0x0f, 0xdc, 0xe3, 0x90, 0xd2, 0xb9, 0xe6, 0x63, 0x73, 0xab, 0xe6, 0xd4, 0xa2, 0x79, 0xb0,
+	0x43, 0x08, 0xf6, 0x02, 0xaa, 0xfc, 0xbb, 0xb9, 0x2f, 0x7c, 0x1e, 0x37, 0x1f, 0x51, 0x68, 0x49,
+	0x4e, 0x31, 0x12, 0x11, 0xe7, 0xa2, 0x8b, 0xc4, 0x4b, 0x27, 0x65, 0xca, 0xbd, 0xc2, 0xad, 0x85,
+	0x57, 0xf8, 0x09, 0x14, 0xc3, 0xb9, 0x88, 0x9b, 0x1f, 0xd1, 0x42, 0x1b, 0xb9, 0x9b, 0x33, 0x9c,
+	0xa3, 0x1b, 0x23, 0x59, 0xcb, 0xa2, 0x3e, 0xd6, 0xb3, 0x28, 0xfb, 0x77, 0x06, 0x40, 0xc6, 0x8c,
+	0x6c, 0x5a, 0x16, 0x6f, 0xa6, 0x69, 0x7b, 0x13, 0x2a, 0x53, 0xee, 0x4d, 0x50, 0x80, 0x82, 0x0c,
+	0x01, 0x0a, 0xc4, 0xfb, 0x41, 0xfb, 0x9b, 0xf2, 0xaa, 0xd1, 0x66, 0x9f, 0x42, 0x69, 0x12, 0x79,
+	0x17, 0x71, 0xb3, 0xf8, 0xd8, 0xdc, 0x5a, 0x4b, 0x6f, 0x55, 0x27, 0xf2, 0x2e, 0x1c, 0x49, 0x61,
+	0x8f, 0xa1, 0x3e, 0x8b, 0xc2, 0x63, 0xef, 0xd8, 0x9f, 0xfa, 0xe2, 0x92, 0x2e, 0xaf, 0xe1, 0xe8,
+	0x28, 0xfb, 0x1f, 0x0d, 0x58, 0xd5, 0xf5, 0x81, 0x09, 0x5f, 0x34, 0x0f, 0x48, 0xb0, 0x92, 0x83,
+	0xc3, 0x1f, 0x14, 0x64, 0x36, 0xa1, 0x44, 0xf6, 0x50, 0x82, 0x4a, 0x80, 0x3d, 0x83, 0x12, 0xda,
+	0x57, 0x4a, 0x9a, 0xa9, 0x0f, 0x8d, 0xab, 0xac, 0x20, 0xe9, 0xf6, 0x21, 0xac, 0x3a, 0xf3, 0xa0,
+	0x7d, 0x1a, 0x71, 0x4e, 0x3e, 0xbd, 0x99, 0xd4, 0x31, 0x52, 0x4f, 0x12, 0xd0, 0xd4, 0x57, 0xc8,
+	0xa9, 0x8f, 0x41, 0x31, 0x9a, 0x07, 0x52, 0x49, 0x25, 0x87, 0xc6, 0xf6, 0xbf, 0x19, 0xb0, 0xfa,
+	0x9a, 0x47, 0xfe, 0x5b, 0xff, 0xc4, 0xa3, 0xe8, 0x71, 0xfd, 0x92, 0x9b, 0x50, 0x3a, 0xf7, 0xa6,
+	0xfe, 0x44, 0xe9, 0x5d, 0x02, 0x0b, 0x57, 0xce, 0xbc, 0xed, 0xca, 0x15, 0x6f, 0xbb, 0x72, 0xa5,
+	0x2b, 0x57, 0x2e, 0x09, 0xb5, 0xe5, 0x2c, 0xd4, 0xda, 0xcf, 0xa1, 0x2c, 0x73, 0x69, 0xf6, 0x59,
+	0x9a, 0x6a, 0xcb, 0xb7, 0x31, 0x5f, 0xc7, 0x29, 0x9a, 0xfd, 0xbb, 0x02, 0x98, 0x98, 0xff, 0xfe,
+	0x21, 0x8f, 0x43, 0xaa, 0x15, 0x53, 0xd7, 0x4a, 0x12, 0x95, 0x8b, 0xf9, 0xa8, 0xac, 0x94, 0x5f,
+	0xca, 0x29, 0x3f, 0x2d, 0xf3, 0xca, 0x7a, 0x99, 0xf7, 0x14, 0x8a, 0xe2, 0x72, 0x26, 0x33, 0x83,
+	0x4c, 0x82, 0x1d, 0x2e, 0xf0, 0x6f, 0x7c, 0x39, 0xe3, 0x0e, 0xd1, 0xed, 0x31, 0x54, 0x14, 0x82,
+	0x55, 0xa1, 0x38, 0x18, 0x0e, 0xba, 0xd6, 0x0a, 0x8e, 0xf6, 0x86, 0x07, 0x1d, 0xcb, 0xc0, 0xd1,
+	0x6e, 0xfb, 0xe0, 0xc0, 0x2a, 0xb0, 0x1a, 0x94, 0x9c, 0x76, 0x7f, 0xd4, 0xb5, 0x4c, 0x1c, 0x8e,
+	0x5e, 0x21, 0xb6, 0xc8, 0x2a, 0x60, 0xee, 0xf4, 0xf7, 0xad, 0x12, 0x5b, 0x85, 0xea, 0x8e, 0xd3,
+	0x1f, 0xec, 0xbb, 0xfd, 0x81, 0x55, 0xb6, 0x9f, 0x42, 0x11, 0xdf, 0x18, 0xf6, 0x89, 0x7a, 0x7e,
+	0xa4, 0x16, 0x21, 0x93, 0x42, 0xbe, 0x3e, 0xf6, 0xaf, 0x80, 0xa1, 0x2f, 0xf6, 0xfc, 0x58, 0x84,
+	0x51, 0x9a, 0x44, 0x5c, 0x97, 0x24, 0x6f, 0xea, 0x85, 0x75, 0xa2, 0x27, 0xfb, 0xe7, 0x50, 0xd7,
+	0xe6, 0xa3, 0x8a, 0x34, 0xb3, 0x99, 0x69, 0x49, 0x84, 0xef, 0x25, 0xff, 0x20, 0x92, 0xcc, 0x18,
+	0xc7, 0xf6, 0xef, 0x4d, 0xa8, 0xd1, 0x3d, 0x38, 0xe7, 0xc1, 0x55, 0x13, 0x26, 0x22, 0x14, 0xae,
+	0x13, 0x21, 0x67, 0x2a, 0x0b, 0xcc, 0x98, 0x7f, 0xa7, 0x2c, 0x85, 0x43, 0xb6, 0xa5, 0x54, 0x5f,
+	0x22, 0xd5, 0x6f, 0xea, 0x77, 0x0e, 0xf7, 0xca, 0x94, 0xaf, 0x39, 0x4a, 0xf9, 0x56, 0x47, 0xc9,
+	0xcc, 0x5f, 0x59, 0xbc, 0x7b, 0x54, 0xcf, 0x57, 0xb5, 0x7a, 0x3e, 0x75, 0x89, 0x9a, 0xee, 0x12,
+	0x69, 0x31, 0x0f, 0x7a, 0x31, 0xaf, 0x57, 0xe6, 0xf5, 0x7c, 0x65, 0xfe, 0x19, 0x98, 0x58, 0xec,
+	0xad, 0xde, 0xe8, 0x42, 0x48, 0x4e, 0x6f, 0x52, 0x23, 0x9f, 0xb4, 0x08, 0xff, 0x8c, 0xd3, 0xbb,
+	0x6d, 0x3a, 0x34, 0x4e, 0x83, 0xc4, 0x7a, 0x16, 0x24, 0xd8, 0x33, 0xa8, 0xc6, 0xb2, 0xc1, 0x11,
+	0x37, 0xad, 0x5c, 0x8a, 0x42, 0x09, 0x68, 0x4a, 0xb4, 0x47, 0xb0, 0x3e, 0x9a, 0xf1, 0x13, 0xe1,
+	0x09, 0xbe, 0xcc, 0x4b, 0x6e, 0x0a, 0x50, 0x9b, 0x50, 0x9a, 0xf0, 0xa9, 0x77, 0x99, 0x98, 0x8e,
+	0x00, 0xfb, 0xaf, 0x61, 0x4d, 0x2d, 0x1a, 0x46, 0xd2, 0x0d, 0x9e, 0x42, 0x89, 0xe3, 0x40, 0x15,
+	0xf6, 0xd6, 0xa2, 0xed, 0x1c, 0x49, 0x46, 0xa3, 0xcf, 0x42, 0xa1, 0x36, 0xc1, 0xa1, 0xfd, 0x4b,
+	0x68, 0x38, 0x1c, 0x77, 0x4b, 0xc4, 0xbb, 0x31, 0xdc, 0xc5, 0x33, 0x8c, 0x48, 0x05, 0x7a, 0x11,
+	0x24, 0x60, 0xff, 0xbd, 0x01, 0x4c, 0xbe, 0x52, 0x68, 0xf4, 0xb4, 0x56, 0xb9, 0xe9, 0xb5, 0xba,
+	0xce, 0x39, 0x31, 0x4d, 0x8c, 0xc2, 0x33, 0x75, 0x40, 0x1a, 0xa3, 0x53, 0x8b, 0x50, 0x79, 0x66,
+	0x41, 0x84, 0xf9, 0x7c, 0xba, 0x94, 0xcf, 0xa7, 0xed, 0x7f, 0x2a, 0x40, 0x5d, 0x93, 0xe1, 0xc6,
+	0xcd, 0x37, 0x93, 0x27, 0x45, 0x5d, 0x44, 0x02, 0x70, 0xfb, 0xf3, 0x99, 0x3f, 0xa3, 0xed, 0x0d,
+	0x87, 0xc6, 0xa4, 0xa4, 0xb7, 0xb2, 0xad, 0x61, 0x38, 0x38, 0x44, 0x01, 0xc4, 0xbb, 0x88, 0x73,
+	0xea, 0x21, 0xc8, 0x37, 0xb1, 0x4a, 0x08, 0x8c, 0xa2, 0x9f, 0xc3, 0x86, 0x77, 0x7a, 0x1a, 0xf1,
+	0x38, 0xf6, 0xc3, 0xc0, 0x7d, 0xeb, 0x9d, 0x88, 0x30, 0xa2, 0x7b, 0x61, 0x38, 0x56, 0x46, 0xd8,
+	0x23, 0x3c, 0xdb, 0x02, 0xeb, 0x02, 0xa3, 0xbb, 0x08, 0xdd, 0xf8, 0x5d, 0x78, 0x31, 0x09, 0x2f,
+	0x02, 0xba, 0x17, 0x86, 0xb3, 0x86, 0xf8, 0x71, 0x38, 0x52, 0x58, 0xf6, 0x14, 0xd6, 0x2f, 0xc2,
+	0xc0, 0xf5, 0x44, 0xc6, 0x58, 0x25, 0xc6, 0xc6, 0x45, 0x18, 0xb4, 0x45, 0xca, 0x67, 0x81, 0x19,
+	0x70, 0xa1, 0x6e, 0x0c, 0x0e, 0xed, 0x31, 0x94, 0x47, 0xdc, 0x8b, 0xc3, 0xe0, 0xae, 0xdd, 0xb6,
+	0x58, 0x78, 0x91, 0x48, 0x5c, 0x8c, 0x00, 0x5c, 0x95, 0x07, 0x93, 0x24, 0x3a, 0xf0, 0x60, 0x62,
+	0xff, 0x97, 0x01, 0xec, 0x80, 0x7b, 0x13, 0x1e, 0xd1, 0x13, 0xad, 0xd9, 0x3a, 0xa6, 0xcd, 0x12,
+	0x75, 0x4b, 0x88, 0x7d, 0x0d, 0x95, 0xc8, 0x0b, 0xde, 0x27, 0x99, 0xc9, 0xda, 0xcb, 0x47, 0x49,
+	0x99, 0xa6, 0xad, 0x21, 0x19, 0x9c, 0x84, 0x73, 0xa1, 0x0a, 0x33, 0x97, 0x54, 0x61, 0xc5, 0xdb,
+	0x13, 0xeb, 0xa4, 0xc6, 0x2c, 0xdd, 0x54, 0x63, 0x96, 0x17, 0x6a, 0xcc, 0xef, 0x0d, 0xa8, 0x8e,
+	0x84, 0x17, 0x24, 0xf9, 0x13, 0x4a, 0x95, 0xdc, 0x52, 0x1c, 0x2f, 0x4b, 0x23, 0x48, 0xad, 0xa6,
+	0xa6, 0x56, 0x65, 0x96, 0x62, 0x6a, 0x96, 0xcc, 0x01, 0x4b, 0xba, 0x03, 0xe2, 0x9a, 0xa1, 0x1f,
+	0x88, 0xe4, 0x19, 0x54, 0x90, 0xfd, 0x77, 0x50, 0xd7, 0x34, 0x75, 0xa3, 0x9a, 0xbf, 0x84, 0x5a,
+	0xac, 0x44, 0x46, 0xcf, 0xc6, 0xd7, 0x6a, 0x3d, 0x4d, 0x5a, 0x25, 0xde, 0xc9, 0x38, 0xb2, 0x92,
+	0xd8, 0xd4, 0x4b, 0xe2, 0x77, 0x50, 0x74, 0xbc, 0xf7, 0xfc, 0x0f, 0x7b, 0x4c, 0x66, 0x69, 0x6f,
+	0x16, 0x87, 0x59, 0x28, 0x2f, 0x69, 0xa1, 0xdc, 0xfe, 0x5b, 0xa8, 0xe1, 0x4e, 0x63, 0xdc, 0xf6,
+	0xda, 0x40, 0x68, 0x81, 0x39, 0xf1, 0x2e, 0x95, 0x83, 0xe2, 0xf0, 0x86, 0x6e, 0xf0, 0x66, 0x96,
+	0x20, 0x66, 0xca, 0xb4, 0xff, 0xc1, 0x00, 0xc0, 0xf5, 0x1d, 0x3e, 0x0b, 0xa3, 0xeb, 0x23, 0x6d,
+	0xda, 0x3c, 0x28, 0xe4, 0x9a, 0x07, 0xa9, 0x54, 0x49, 0xf3, 0xe0, 0x33, 0x28, 0x4e, 0xbc, 0x4b,
+	0xdc, 0xf5, 0x7a, 0x36, 0xa2, 0x66, 0xc2, 0x15, 0xf5, 0x53, 0xfe, 0xd6, 0x80, 0x86, 0x4a, 0x53,
+	0xb3, 0xa0, 0xfa, 0xce, 0x4b, 0xde, 0xf7, 0x5a, 0x62, 0xfb, 0x34, 0xf7, 0x2d, 0xe8, 0xb9, 0x2f,
+	0xbd, 0x4b, 0x5e, 0x92, 0x10, 0xd3, 0x18, 0xf3, 0x4a, 0x5f, 0xf0, 0x88, 0x12, 0xd2, 0x64, 0x33,
+	0x0d, 0x83, 0x73, 0xd2, 0x7c, 0x11, 0x1f, 0x53, 0x0c, 0xce, 0xdf, 0x1b, 0xc0, 0x1c, 0x2f, 0x38,
+	0xe5, 0x79, 0x51, 0x30, 0xd7, 0x40, 0x6c, 0x22, 0x8b, 0x82, 0xfe, 0x9f, 0x85, 0x11, 0x00, 0x59,
+	0xf2, 0x8e, 0x1c, 0x54, 0xbe, 0x19, 0x72, 0x55, 0x1c, 0xa3, 0xe5, 0x2f, 0xfc, 0x40, 0xbd, 0x2f,
+	0x38, 0x44, 0x8c, 0xf0, 0xb9, 0x0a, 0xcd, 0x38, 0x44, 0xd9, 0xa9, 0xf8, 0xba, 0x54, 0xc1, 0x59,
+	0x41, 0x37, 0xa5, 0x98, 0xb6, 0x0f, 0x65, 0xb5, 0xe3, 0x33, 0xdd, 0x00, 0x4b, 0x0a, 0x0a, 0x5c,
+	0x4a, 0xd5, 0x87, 0xea, 0x8e, 0x4b, 0x08, 0x0f, 0xcd, 0x3f, 0xbc, 0xf3, 0xe6, 0xb1, 0xf0, 0xcf,
+	0xb9, 0x0a, 0x4d, 0x1a, 0x66, 0xfb, 0x1c, 0xea, 0x5a, 0x14, 0x62, 0x00, 0xe5, 0xde, 0xf0, 0xa0,
+	0xd3, 0x7d, 0x65, 0xad, 0x60, 0xda, 0x39, 0x7c, 0xd5, 0xee, 0xb5, 0x2d, 0x83, 0x59, 0xb0, 0x2a,
+	0xd1, 0x6e, 0xaf, 0xef, 0x1e, 0x0c, 0xad, 0x02, 0x5b, 0x87, 0x3a, 0x11, 0x15, 0xc2, 0x64, 0x6b,
+	0x00, 0xa3, 0xde, 0xd0, 0x19, 0xbb, 0x9d, 0xee, 0xee, 0xb7, 0x56, 0x91, 0xdd, 0x83, 0xf5, 0x51,
+	0xf7, 0x75, 0x77, 0xe0, 0xee, 0xb6, 0x9d, 0x8e, 0x3b, 0x1a, 0x1f, 0x75, 0xac, 0x12, 0xa6, 0xb7,
+	0x4e, 0xfb, 0xd7, 0xbf, 0xb6, 0xca, 0xdb, 0xbf, 0x80, 0x6a, 0xd2, 0xe6, 0x64, 0x1b, 0xd0, 0xe8,
+	0x74, 0xf7, 0xda, 0x47, 0x07, 0x63, 0xf7, 0xa0, 0xff, 0xaa, 0x3f, 0xb6, 0x56, 0x30, 0xbd, 0x1d,
+	0x0c, 0x15, 0x64, 0xb0, 0x06, 0xd4, 0x0e, 0x87, 0x09, 0xb1, 0xb0, 0xfd, 0xaf, 0x06, 0xac, 0xea,
+	0x05, 0x3a, 0xab, 0x43, 0x65, 0x30, 0x74, 0x7b, 0xed, 0x41, 0xc7, 0x5a, 0x41, 0xe6, 0x5e, 0x7f,
+	0xbf, 0x47, 0xfb, 0x5a, 0x06, 0xae, 0x34, 0x1c, 0x74, 0xdd, 0xc3, 0x76, 0xdf, 0xb1, 0x0a, 0x08,
+	0x8d, 0xdf, 0x0c, 0x25, 0x64, 0xa2, 0x8c, 0xe3, 0x9e, 0xd3, 0xed, 0xba, 0xc3, 0x3d, 0xb7, 0xed,
+	0x7e, 0xdb, 0x1f, 0x74, 0xac, 0x22, 0xb2, 0x8c, 0xc6, 0x4e, 0xbb, 0xbf, 0xdf, 0x1b, 0x5b, 0x25,
+	0x54, 0xc2, 0xde, 0xc1, 0xd1, 0xa8, 0x67, 0x95, 0xf1, 0x84, 0x7b, 0x47, 0x07, 0x07, 0x6e, 0x6f,
+	0x78, 0x34, 0xea, 0x5a, 0x15, 0xc6, 0x60, 0x6d, 0x6f, 0x78, 0xe4, 0x68, 0x93, 0xab, 0x88, 0x4b,
+	0x26, 0xbb, 0x72, 0x5e, 0x6d, 0xfb, 0xb7, 0x06, 0x14, 0xb1, 0x56, 0x55, 0x62, 0x76, 0x9c, 0xf6,
+	0x1b, 0x6b, 0x85, 0x56, 0x43, 0x06, 0x09, 0x1b, 0xec, 0x63, 0x68, 0x0e, 0x0f, 0xbb, 0x03, 0xb7,
+	0x3b, 0xe8, 0x74, 0x3b, 0x6e, 0xba, 0x08, 0x51, 0x0b, 0x38, 0x75, 0xff, 0x68, 0x3c, 0xea, 0x0d,
+	0xc7, 0x96, 0xc9, 0x1e, 0xc2, 0xbd, 0x9d, 0xf6, 0xee, 0xb7, 0x9d, 0xe1, 0xd0, 0x71, 0xb5, 0x35,
+	0x8a, 0xac, 0x05, 0x0f, 0x52, 0x42, 0x7e, 0x85, 0xd2, 0xf6, 0xbf, 0x1b, 0x50, 0xd7, 0xf2, 0x5c,
+	0x34, 0xe0, 0x60, 0x38, 0x76, 0x47, 0xe3, 0xb6, 0x33, 0xee, 0x76, 0xa4, 0xca, 0x0f, 0x9d, 0xae,
+	0xbb, 0x77, 0x30, 0x3c, 0x94, 0x85, 0x08, 0x8d, 0x64, 0x21, 0xd2, 0x7f, 0xdd, 0x45, 0x7d, 0x55,
+	0xa1, 0x38, 0x3e, 0x72, 0x06, 0x56, 0x11, 0x47, 0xa3, 0xde, 0xf0, 0x8d, 0x34, 0xe9, 0x10, 0xa9,
+	0x65, 0x74, 0x92, 0x71, 0xaf, 0x4f, 0xc6, 0x76, 0xba, 0xdd, 0xb1, 0x55, 0x41, 0xc3, 0xa2, 0x86,
+	0xc6, 0xbd, 0x04, 0x55, 0x45, 0xa6, 0xbd, 0xfe, 0x5e, 0x86, 0xa9, 0x21, 0x66, 0xd4, 0xff, 0x9b,
+	0x0c, 0x03, 0xa4, 0x44, 0x74, 0x9d, 0x0c, 0x57, 0xdf, 0x3e, 0x85, 0x46, 0x2e, 0xa3, 0xa7, 0xa2,
+	0x08, 0x65, 0x97, 0xe5, 0xd3, 0xa8, 0xdb, 0x1e, 0x4b, 0xa9, 0x3b, 0xdd, 0x36, 0x96, 0x4f, 0x58,
+	0x28, 0x75, 0x51, 0x59, 0x00, 0x65, 0xb5, 0x48, 0x91, 0xc6, 0xdd, 0xf1, 0xf8, 0xa0, 0xab, 0x5c,
+	0xf1, 0x68, 0x30, 0xb2, 0xca, 0x64, 0xf0, 0xde, 0xf0, 0x4d, 0x67, 0xf8, 0x66, 0x60, 0x55, 0xb6,
+	0x7f, 0x9a, 0x4f, 0x17, 0xd4, 0x0b, 0x5f, 0x01, 0x73, 0xd0, 0x1d, 0xcb, 0x4b, 0x81, 0x7e, 0x36,
+	0xb2, 0x0c, 0x5c, 0xed, 0x70, 0xd8, 0x1f, 0x8c, 0x47, 0x56, 0xe1, 0xe5, 0xff, 0xb4, 0xa0, 0x74,
+	0x88, 0x57, 0x93, 0x3d, 0x87, 0xd5, 0xdd, 0x88, 0x7b, 0x82, 0xab, 0xce, 0x57, 0xfe, 0xf3, 0x56,
+	0x2b, 0x0f, 0xda, 0x2b, 0xec, 0x27, 0xd0, 0xd0, 0xf9, 0x63, 0xb6, 0xd0, 0xad, 0x6c, 0x2d, 0xc0,
+	0xf6, 0x0a, 0xfb, 0x39, 0x34, 0x3a, 0x7c, 0xca, 0x6f, 0x9e, 0xf2, 0xe0, 0xb9, 0xfc, 0xd6, 0xfa,
+	0x3c, 0xf9, 0xd6, 0xfa, 0xbc, 0x8b, 0xdf, 0x5a, 0xed, 0x15, 0xf6, 0x39, 0xd4, 0xf6, 0xb9, 0xb8,
+	0xa3, 0x68, 0x3f, 0x05, 0x2b, 0x65, 0x8e, 0x77, 0x2e, 0xa9, 0x9d, 0x75, 0xbb, 0x74, 0x7f, 0x0e,
+	0xec, 0x68, 0x36, 0xc9, 0x0e, 0xb4, 0x4b, 0x0f, 0xdf, 0xff, 0x61, 0x1e, 0xc5, 0xaf, 0xdb, 0xe7,
+	0xbd, 0x80, 0xc6, 0x28, 0x91, 0x72, 0x84, 0xd5, 0xd8, 0x6d, 0xc7, 0xda, 0x02, 0x90, 0x1a, 0xa7,
+	0xaf, 0x3a, 0x7a, 0x01, 0xd4, 0xd2, 0x01, 0xd2, 0x56, 0x63, 0x9f, 0xd3, 0xf7, 0x0c, 0x75, 0xfa,
+	0x65, 0xcc, 0x4f, 0xa0, 0xa2, 0x98, 0x97, 0xb2, 0xfd, 0x19, 0xd4, 0xa5, 0xf1, 0xe4, 0xf7, 0xac,
+	0x55, 0x8d, 0xba, 0xcc, 0x70, 0x2f, 0x60, 0xa3, 0x3d, 0x9d, 0x86, 0x27, 0x4a, 0x6c, 0x3c, 0x68,
+	0xbc, 0x74, 0x9f, 0xaf, 0x80, 0x8d, 0xb8, 0xd8, 0x99, 0x0b, 0x11, 0x06, 0x87, 0x61, 0xec, 0xcb,
+	0x37, 0x6e, 0xd9, 0x8c, 0xcf, 0x30, 0xff, 0x16, 0xaf, 0xfc, 0x60, 0x29, 0xd7, 0x33, 0xa8, 0xe1,
+	0xba, 0x58, 0xc3, 0xc4, 0xb7, 0xe9, 0x63, 0xc4, 0x05, 0x25, 0x68, 0xcb, 0xd8, 0xbe, 0x84, 0xf5,
+	0xd7, 0xd8, 0x83, 0x42, 0xc3, 0x47, 0xb7, 0x9b, 0x64, 0x0b, 0x60, 0xc0, 0x3f, 0x88, 0x8e, 0xfc,
+	0x68, 0xbb, 0x8c, 0xf3, 0x05, 0x6c, 0x48, 0x7f, 0x42, 0x38, 0xf9, 0xf8, 0xb0, 0x6c, 0xc2, 0x73,
+	0xb0, 0xb2, 0x09, 0x2a, 0x4a, 0x2e, 0xe3, 0xff, 0x06, 0x6a, 0xe9, 0xe7, 0x2e, 0xf6, 0x30, 0x29,
+	0x13, 0x16, 0x3e, 0x80, 0xb5, 0xd6, 0xb5, 0x49, 0x48, 0xb4, 0x57, 0xd8, 0x5f, 0x00, 0x64, 0x5f,
+	0x72, 0x58, 0x53, 0x31, 0x5c, 0xf9, 0xb8, 0xd3, 0xba, 0xf2, 0x85, 0xc8, 0x5e, 0xf9, 0xca, 0x60,
+	0xdf, 0xc0, 0x03, 0xe5, 0x67, 0xe9, 0xcd, 0xa4, 0x13, 0x2e, 0x1c, 0xee, 0xba, 0x8b, 0xb2, 0x36,
+	0xca, 0x4d, 0xbc, 0x6d, 0xc2, 0x5f, 0xc1, 0xa6, 0xc3, 0xcf, 0xc2, 0x73, 0xc5, 0xbf, 0x17, 0x85,
+	0x67, 0x64, 0x9f, 0x85, 0x0b, 0x76, 0xb3, 0xd3, 0x7e, 0x09, 0xf5, 0x7d, 0x2e, 0xd2, 0x8f, 0xb1,
+	0xb9, 0xed, 0xd6, 0xd3, 0x63, 0x4b, 0xaa, 0xbd, 0xc2, 0xbe, 0x86, 0xc6, 0x01, 0xf7, 0xce, 0x79,
+	0x3a, 0x61, 0x91, 0xe7, 0xba, 0x49, 0xbf, 0x80, 0xe6, 0x3e, 0x17, 0x64, 0xdd, 0x54, 0x1f, 0x04,
+	0xf5, 0x27, 0x2c, 0xd7, 0x46, 0xbc, 0xe6, 0x80, 0x2f, 0x81, 0xc9, 0x48, 0xa0, 0x4f, 0x5f, 0x98,
+	0x95, 0x83, 0x68, 0xce, 0x3d, 0x6d, 0x4e, 0xaa, 0x93, 0xdc, 0xd9, 0x16, 0xe7, 0x6c, 0x41, 0x35,
+	0x91, 0xf1, 0x96, 0xd5, 0xbf, 0x02, 0x4b, 0xbb, 0x0d, 0x77, 0x99, 0xb1, 0x0d, 0x30, 0xc2, 0xd2,
+	0xf7, 0x2e, 0xbc, 0x3f, 0x86, 0x1a, 0x5e, 0x1c, 0x19, 0x59, 0x6f, 0x5d, 0x56, 0x5e, 0x86, 0x0e,
+	0xf6, 0x97, 0x96, 0xf3, 0x6e, 0x41, 0x15, 0x97, 0xc5, 0x1f, 0x0f, 0xdc, 0x4d, 0x00, 0x87, 0xbe,
+	0x95, 0xdd, 0x69, 0xd1, 0x31, 0x7e, 0x6b, 0x5b, 0xce, 0xf9, 0x1c, 0xd6, 0x90, 0x73, 0x24, 0xe6,
+	0x13, 0xd9, 0xe0, 0xbf, 0xfd, 0x68, 0xd2, 0x82, 0x77, 0x38, 0xda, 0x8f, 0x29, 0xda, 0xb5, 0xe5,
+	0xf7, 0xb8, 0xe5, 0xac, 0x3f, 0x49, 0xe2, 0x8d, 0x9e, 0x65, 0x2d, 0x9f, 0xf2, 0x05, 0xac, 0x8e,
+	0xb8, 0xc0, 0x78, 0x36, 0xa4, 0x1f, 0x76, 0xdc, 0x95, 0xfb, 0x2e, 0xb6, 0x7e, 0x01, 0xeb, 0x9a,
+	0x38, 0x77, 0xb0, 0xcd, 0x57, 0x49, 0xf8, 0x23, 0xc4, 0x5d, 0x4c, 0x94, 0xdf, 0xe2, 0x0e, 0x96,
+	0xfa, 0x1c, 0x56, 0x93, 0x7b, 0x40, 0xed, 0xea, 0x3c, 0xb7, 0xfe, 0xb5, 0x94, 0xb2, 0x8f, 0xfb,
+	0x3a, 0xf3, 0x5e, 0x18, 0x5d, 0xab, 0xd3, 0x85, 0x59, 0x4f, 0xa0, 0xf2, 0xca, 0x7b, 0x4f, 0x0d,
+	0x2e, 0xad, 0xfd, 0x7d, 0x45, 0x92, 0x2f, 0xa1, 0xd1, 0x3d, 0xf7, 0xa6, 0x73, 0x4f, 0xf0, 0x1e,
+	0xd5, 0x4f, 0xb7, 0x9d, 0x74, 0x2d, 0xcd, 0x84, 0xae, 0x33, 0xd5, 0x95, 0x1c, 0xe3, 0x1b, 0xb8,
+	0xaf, 0x27, 0x33, 0x83, 0x50, 0xa8, 0x5f, 0x78, 0xdd, 0x96, 0x9c, 0xec, 0x51, 0x38, 0xd3, 0x7f,
+	0x0a, 0xb7, 0x17, 0x46, 0x92, 0xca, 0x92, 0xcf, 0x7a, 0x3a, 0xb5, 0x75, 0x1d, 0xd2, 0x5e, 0x61,
+	0xbf, 0x84, 0x46, 0x3f, 0xde, 0xc9, 0x7e, 0xcc, 0xf6, 0x83, 0x26, 0xbf, 0x84, 0x3a, 0x7d, 0x4a,
+	0xba, 0xbc, 0xce, 0xd1, 0x92, 0x39, 0xfa, 0xc7, 0x26, 0x4a, 0x4a, 0xeb, 0xf4, 0x39, 0x6b, 0x1c,
+	0x3a, 0xd8, 0x69, 0x4e, 0xb8, 0xf4, 0xaf, 0x5c, 0xad, 0xeb, 0x90, 0xa4, 0xac, 0xc6, 0xbe, 0xcc,
+	0x14, 0x54, 0x03, 0x64, 0x43, 0x6b, 0x5b, 0x48, 0x54, 0xeb, 0x2a, 0xca, 0x5e, 0x61, 0x1d, 0xd8,
+	0xe8, 0x7e, 0xc0, 0xb1, 0xfe, 0x51, 0xe2, 0x91, 0x56, 0x23, 0xe7, 0x3f, 0x74, 0xb4, 0xd8, 0x55,
+	0x92, 0xbd, 0xc2, 0x7e, 0x06, 0x20, 0x5b, 0xc9, 0xf2, 0x27, 0x78, 0xc9, 0x46, 0x7a, 0x77, 0xb9,
+	0x75, 0xa5, 0x33, 0x4d, 0x4f, 0xf1, 0x5f, 0x42, 0x35, 0xe9, 0x92, 0xb3, 0x07, 0x8a, 0x63, 0xa1,
+	0x6d, 0xde, 0xba, 0x9f, 0xc7, 0x87, 0x51, 0x36, 0xbd, 0xad, 0x79, 0x95, 0x6c, 0x02, 0x3f, 0xca,
+	0xb9, 0x83, 0xde, 0x9c, 0x6e, 0xb1, 0xab, 0x24, 0x7b, 0x25, 0xab, 0x36, 0x54, 0xe7, 0x34, 0xf1,
+	0x27, 0x09, 0xb6, 0xf2, 0xa0, 0xbd, 0xa2, 0xb6, 0xd4, 0x3b, 0x74, 0xd7, 0xf5, 0x37, 0x17, 0xb6,
+	0xd4, 0x48, 0xa4, 0xae, 0xf5, 0x5d, 0x6f, 0x7a, 0x32, 0x9f, 0x7a, 0x42, 0xb5, 0x68, 0x52, 0x9d,
+	0xe5, 0x3a, 0x36, 0xad, 0x46, 0x0e, 0x6b, 0xaf, 0xb0, 0x1d, 0xd8, 0x4c, 0x67, 0x6a, 0x1d, 0x9e,
+	0x54, 0x84, 0xab, 0x5d, 0x9f, 0x2b, 0x6b, 0x1c, 0x97, 0x29, 0xc9, 0xf8, 0xfa, 0x7f, 0x07, 0x00,
+	0x29, 0x17, 0x91, 0xbc, 0x6c, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // PokerClient is the client API for Poker service.
 //
@@ -854,14 +3590,21 @@ type PokerClient interface {
 	AllocateGameSlots(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
 	SetButtonPositions(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
 	SetMin(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
+	SetBlinds(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
+	SetRake(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
 	ValidatePreGame(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
 	NextDealer(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
 	UpdateGameInRound(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
 	UpdateGameStatus(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error)
+	// Lobby RPCs
+	ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*GameList, error)
+	WatchLobby(ctx context.Context, in *WatchLobbyRequest, opts ...grpc.CallOption) (Poker_WatchLobbyClient, error)
 	// GamePlayers (join table)
 	GetGamePlayersByGameId(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Players, error)
 	SetGamePlayers(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Players, error)
 	RemovePlayerFromGame(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetWaitlist(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Waitlist, error)
+	LeaveWaitlist(ctx context.Context, in *Waitlist, opts ...grpc.CallOption) (*Waitlist, error)
 	// RoundPlayers RPCs (join table)
 	GetRoundPlayersByRoundId(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Players, error)
 	CreateRoundPlayers(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
@@ -875,6 +3618,7 @@ type PokerClient interface {
 	DealFlop(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
 	DealRiver(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
 	DealTurn(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
+	DealStudStreet(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
 	CreateDeck(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
 	SetAction(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
 	UpdateRoundStatus(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error)
@@ -891,13 +3635,26 @@ type PokerClient interface {
 	UpdatePlayerNotinHand(ctx context.Context, in *Player, opts ...grpc.CallOption) (*Player, error)
 	GetAmountToCallForPlayer(ctx context.Context, in *AmountToCall, opts ...grpc.CallOption) (*AmountToCall, error)
 	IsBettingOver(ctx context.Context, in *AmountToCall, opts ...grpc.CallOption) (*AmountToCall, error)
+	VerifyRound(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Verification, error)
+	AgreeToRuns(ctx context.Context, in *RunAgreement, opts ...grpc.CallOption) (*RunAgreement, error)
+	// Reporting RPCs
+	GetRakeReport(ctx context.Context, in *RakeReport, opts ...grpc.CallOption) (*RakeReport, error)
+	ExportHandHistory(ctx context.Context, in *HandHistoryRequest, opts ...grpc.CallOption) (*HandHistory, error)
+	ReplayHand(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (Poker_ReplayHandClient, error)
+	Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Poker_SpectateClient, error)
+	GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error)
+	CreateSeason(ctx context.Context, in *Season, opts ...grpc.CallOption) (*Season, error)
+	GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error)
+	// Analysis RPCs
+	CalculateEquity(ctx context.Context, in *EquityRequest, opts ...grpc.CallOption) (*Equity, error)
+	CalculateRangeEquity(ctx context.Context, in *RangeEquityRequest, opts ...grpc.CallOption) (*Equity, error)
 }
 
 type pokerClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewPokerClient(cc *grpc.ClientConn) PokerClient {
+func NewPokerClient(cc grpc.ClientConnInterface) PokerClient {
 	return &pokerClient{cc}
 }
 
@@ -1036,6 +3793,24 @@ func (c *pokerClient) SetMin(ctx context.Context, in *Game, opts ...grpc.CallOpt
 	return out, nil
 }
 
+func (c *pokerClient) SetBlinds(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, "/poker.Poker/SetBlinds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) SetRake(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, "/poker.Poker/SetRake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pokerClient) ValidatePreGame(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Game, error) {
 	out := new(Game)
 	err := c.cc.Invoke(ctx, "/poker.Poker/ValidatePreGame", in, out, opts...)
@@ -1072,6 +3847,47 @@ func (c *pokerClient) UpdateGameStatus(ctx context.Context, in *Game, opts ...gr
 	return out, nil
 }
 
+func (c *pokerClient) ListGames(ctx context.Context, in *ListGamesRequest, opts ...grpc.CallOption) (*GameList, error) {
+	out := new(GameList)
+	err := c.cc.Invoke(ctx, "/poker.Poker/ListGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) WatchLobby(ctx context.Context, in *WatchLobbyRequest, opts ...grpc.CallOption) (Poker_WatchLobbyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Poker_serviceDesc.Streams[0], "/poker.Poker/WatchLobby", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerWatchLobbyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Poker_WatchLobbyClient interface {
+	Recv() (*LobbyGame, error)
+	grpc.ClientStream
+}
+
+type pokerWatchLobbyClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerWatchLobbyClient) Recv() (*LobbyGame, error) {
+	m := new(LobbyGame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *pokerClient) GetGamePlayersByGameId(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Players, error) {
 	out := new(Players)
 	err := c.cc.Invoke(ctx, "/poker.Poker/GetGamePlayersByGameId", in, out, opts...)
@@ -1099,6 +3915,24 @@ func (c *pokerClient) RemovePlayerFromGame(ctx context.Context, in *Player, opts
 	return out, nil
 }
 
+func (c *pokerClient) GetWaitlist(ctx context.Context, in *Game, opts ...grpc.CallOption) (*Waitlist, error) {
+	out := new(Waitlist)
+	err := c.cc.Invoke(ctx, "/poker.Poker/GetWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) LeaveWaitlist(ctx context.Context, in *Waitlist, opts ...grpc.CallOption) (*Waitlist, error) {
+	out := new(Waitlist)
+	err := c.cc.Invoke(ctx, "/poker.Poker/LeaveWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pokerClient) GetRoundPlayersByRoundId(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Players, error) {
 	out := new(Players)
 	err := c.cc.Invoke(ctx, "/poker.Poker/GetRoundPlayersByRoundId", in, out, opts...)
@@ -1198,6 +4032,15 @@ func (c *pokerClient) DealTurn(ctx context.Context, in *Round, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *pokerClient) DealStudStreet(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error) {
+	out := new(Round)
+	err := c.cc.Invoke(ctx, "/poker.Poker/DealStudStreet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pokerClient) CreateDeck(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Round, error) {
 	out := new(Round)
 	err := c.cc.Invoke(ctx, "/poker.Poker/CreateDeck", in, out, opts...)
@@ -1342,6 +4185,151 @@ func (c *pokerClient) IsBettingOver(ctx context.Context, in *AmountToCall, opts
 	return out, nil
 }
 
+func (c *pokerClient) VerifyRound(ctx context.Context, in *Round, opts ...grpc.CallOption) (*Verification, error) {
+	out := new(Verification)
+	err := c.cc.Invoke(ctx, "/poker.Poker/VerifyRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) AgreeToRuns(ctx context.Context, in *RunAgreement, opts ...grpc.CallOption) (*RunAgreement, error) {
+	out := new(RunAgreement)
+	err := c.cc.Invoke(ctx, "/poker.Poker/AgreeToRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) GetRakeReport(ctx context.Context, in *RakeReport, opts ...grpc.CallOption) (*RakeReport, error) {
+	out := new(RakeReport)
+	err := c.cc.Invoke(ctx, "/poker.Poker/GetRakeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) ExportHandHistory(ctx context.Context, in *HandHistoryRequest, opts ...grpc.CallOption) (*HandHistory, error) {
+	out := new(HandHistory)
+	err := c.cc.Invoke(ctx, "/poker.Poker/ExportHandHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) ReplayHand(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (Poker_ReplayHandClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Poker_serviceDesc.Streams[1], "/poker.Poker/ReplayHand", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerReplayHandClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Poker_ReplayHandClient interface {
+	Recv() (*HandEvent, error)
+	grpc.ClientStream
+}
+
+type pokerReplayHandClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerReplayHandClient) Recv() (*HandEvent, error) {
+	m := new(HandEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pokerClient) Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Poker_SpectateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Poker_serviceDesc.Streams[2], "/poker.Poker/Spectate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerSpectateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Poker_SpectateClient interface {
+	Recv() (*SpectatorEvent, error)
+	grpc.ClientStream
+}
+
+type pokerSpectateClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerSpectateClient) Recv() (*SpectatorEvent, error) {
+	m := new(SpectatorEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pokerClient) GetPlayerStats(ctx context.Context, in *PlayerStatsRequest, opts ...grpc.CallOption) (*PlayerStats, error) {
+	out := new(PlayerStats)
+	err := c.cc.Invoke(ctx, "/poker.Poker/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) CreateSeason(ctx context.Context, in *Season, opts ...grpc.CallOption) (*Season, error) {
+	out := new(Season)
+	err := c.cc.Invoke(ctx, "/poker.Poker/CreateSeason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) GetLeaderboard(ctx context.Context, in *LeaderboardRequest, opts ...grpc.CallOption) (*Leaderboard, error) {
+	out := new(Leaderboard)
+	err := c.cc.Invoke(ctx, "/poker.Poker/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) CalculateEquity(ctx context.Context, in *EquityRequest, opts ...grpc.CallOption) (*Equity, error) {
+	out := new(Equity)
+	err := c.cc.Invoke(ctx, "/poker.Poker/CalculateEquity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerClient) CalculateRangeEquity(ctx context.Context, in *RangeEquityRequest, opts ...grpc.CallOption) (*Equity, error) {
+	out := new(Equity)
+	err := c.cc.Invoke(ctx, "/poker.Poker/CalculateRangeEquity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PokerServer is the server API for Poker service.
 type PokerServer interface {
 	// Player RPCs
@@ -1361,14 +4349,21 @@ type PokerServer interface {
 	AllocateGameSlots(context.Context, *Game) (*Game, error)
 	SetButtonPositions(context.Context, *Game) (*Game, error)
 	SetMin(context.Context, *Game) (*Game, error)
+	SetBlinds(context.Context, *Game) (*Game, error)
+	SetRake(context.Context, *Game) (*Game, error)
 	ValidatePreGame(context.Context, *Game) (*Game, error)
 	NextDealer(context.Context, *Game) (*Game, error)
 	UpdateGameInRound(context.Context, *Game) (*Game, error)
 	UpdateGameStatus(context.Context, *Game) (*Game, error)
+	// Lobby RPCs
+	ListGames(context.Context, *ListGamesRequest) (*GameList, error)
+	WatchLobby(*WatchLobbyRequest, Poker_WatchLobbyServer) error
 	// GamePlayers (join table)
 	GetGamePlayersByGameId(context.Context, *Game) (*Players, error)
 	SetGamePlayers(context.Context, *Game) (*Players, error)
 	RemovePlayerFromGame(context.Context, *Player) (*empty.Empty, error)
+	GetWaitlist(context.Context, *Game) (*Waitlist, error)
+	LeaveWaitlist(context.Context, *Waitlist) (*Waitlist, error)
 	// RoundPlayers RPCs (join table)
 	GetRoundPlayersByRoundId(context.Context, *Round) (*Players, error)
 	CreateRoundPlayers(context.Context, *Round) (*Round, error)
@@ -1382,6 +4377,7 @@ type PokerServer interface {
 	DealFlop(context.Context, *Round) (*Round, error)
 	DealRiver(context.Context, *Round) (*Round, error)
 	DealTurn(context.Context, *Round) (*Round, error)
+	DealStudStreet(context.Context, *Round) (*Round, error)
 	CreateDeck(context.Context, *Round) (*Round, error)
 	SetAction(context.Context, *Round) (*Round, error)
 	UpdateRoundStatus(context.Context, *Round) (*Round, error)
@@ -1398,6 +4394,19 @@ type PokerServer interface {
 	UpdatePlayerNotinHand(context.Context, *Player) (*Player, error)
 	GetAmountToCallForPlayer(context.Context, *AmountToCall) (*AmountToCall, error)
 	IsBettingOver(context.Context, *AmountToCall) (*AmountToCall, error)
+	VerifyRound(context.Context, *Round) (*Verification, error)
+	AgreeToRuns(context.Context, *RunAgreement) (*RunAgreement, error)
+	// Reporting RPCs
+	GetRakeReport(context.Context, *RakeReport) (*RakeReport, error)
+	ExportHandHistory(context.Context, *HandHistoryRequest) (*HandHistory, error)
+	ReplayHand(*ReplayRequest, Poker_ReplayHandServer) error
+	Spectate(*SpectateRequest, Poker_SpectateServer) error
+	GetPlayerStats(context.Context, *PlayerStatsRequest) (*PlayerStats, error)
+	CreateSeason(context.Context, *Season) (*Season, error)
+	GetLeaderboard(context.Context, *LeaderboardRequest) (*Leaderboard, error)
+	// Analysis RPCs
+	CalculateEquity(context.Context, *EquityRequest) (*Equity, error)
+	CalculateRangeEquity(context.Context, *RangeEquityRequest) (*Equity, error)
 }
 
 // UnimplementedPokerServer can be embedded to have forward compatible implementations.
@@ -1449,6 +4458,12 @@ func (*UnimplementedPokerServer) SetButtonPositions(ctx context.Context, req *Ga
 func (*UnimplementedPokerServer) SetMin(ctx context.Context, req *Game) (*Game, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMin not implemented")
 }
+func (*UnimplementedPokerServer) SetBlinds(ctx context.Context, req *Game) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlinds not implemented")
+}
+func (*UnimplementedPokerServer) SetRake(ctx context.Context, req *Game) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRake not implemented")
+}
 func (*UnimplementedPokerServer) ValidatePreGame(ctx context.Context, req *Game) (*Game, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatePreGame not implemented")
 }
@@ -1461,6 +4476,12 @@ func (*UnimplementedPokerServer) UpdateGameInRound(ctx context.Context, req *Gam
 func (*UnimplementedPokerServer) UpdateGameStatus(ctx context.Context, req *Game) (*Game, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameStatus not implemented")
 }
+func (*UnimplementedPokerServer) ListGames(ctx context.Context, req *ListGamesRequest) (*GameList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGames not implemented")
+}
+func (*UnimplementedPokerServer) WatchLobby(req *WatchLobbyRequest, srv Poker_WatchLobbyServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLobby not implemented")
+}
 func (*UnimplementedPokerServer) GetGamePlayersByGameId(ctx context.Context, req *Game) (*Players, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGamePlayersByGameId not implemented")
 }
@@ -1470,6 +4491,12 @@ func (*UnimplementedPokerServer) SetGamePlayers(ctx context.Context, req *Game)
 func (*UnimplementedPokerServer) RemovePlayerFromGame(ctx context.Context, req *Player) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePlayerFromGame not implemented")
 }
+func (*UnimplementedPokerServer) GetWaitlist(ctx context.Context, req *Game) (*Waitlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlist not implemented")
+}
+func (*UnimplementedPokerServer) LeaveWaitlist(ctx context.Context, req *Waitlist) (*Waitlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
 func (*UnimplementedPokerServer) GetRoundPlayersByRoundId(ctx context.Context, req *Round) (*Players, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoundPlayersByRoundId not implemented")
 }
@@ -1503,6 +4530,9 @@ func (*UnimplementedPokerServer) DealRiver(ctx context.Context, req *Round) (*Ro
 func (*UnimplementedPokerServer) DealTurn(ctx context.Context, req *Round) (*Round, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DealTurn not implemented")
 }
+func (*UnimplementedPokerServer) DealStudStreet(ctx context.Context, req *Round) (*Round, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DealStudStreet not implemented")
+}
 func (*UnimplementedPokerServer) CreateDeck(ctx context.Context, req *Round) (*Round, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeck not implemented")
 }
@@ -1551,6 +4581,39 @@ func (*UnimplementedPokerServer) GetAmountToCallForPlayer(ctx context.Context, r
 func (*UnimplementedPokerServer) IsBettingOver(ctx context.Context, req *AmountToCall) (*AmountToCall, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsBettingOver not implemented")
 }
+func (*UnimplementedPokerServer) VerifyRound(ctx context.Context, req *Round) (*Verification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRound not implemented")
+}
+func (*UnimplementedPokerServer) AgreeToRuns(ctx context.Context, req *RunAgreement) (*RunAgreement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgreeToRuns not implemented")
+}
+func (*UnimplementedPokerServer) GetRakeReport(ctx context.Context, req *RakeReport) (*RakeReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRakeReport not implemented")
+}
+func (*UnimplementedPokerServer) ExportHandHistory(ctx context.Context, req *HandHistoryRequest) (*HandHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportHandHistory not implemented")
+}
+func (*UnimplementedPokerServer) ReplayHand(req *ReplayRequest, srv Poker_ReplayHandServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayHand not implemented")
+}
+func (*UnimplementedPokerServer) Spectate(req *SpectateRequest, srv Poker_SpectateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Spectate not implemented")
+}
+func (*UnimplementedPokerServer) GetPlayerStats(ctx context.Context, req *PlayerStatsRequest) (*PlayerStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (*UnimplementedPokerServer) CreateSeason(ctx context.Context, req *Season) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeason not implemented")
+}
+func (*UnimplementedPokerServer) GetLeaderboard(ctx context.Context, req *LeaderboardRequest) (*Leaderboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (*UnimplementedPokerServer) CalculateEquity(ctx context.Context, req *EquityRequest) (*Equity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateEquity not implemented")
+}
+func (*UnimplementedPokerServer) CalculateRangeEquity(ctx context.Context, req *RangeEquityRequest) (*Equity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateRangeEquity not implemented")
+}
 
 func RegisterPokerServer(s *grpc.Server, srv PokerServer) {
 	s.RegisterService(&_Poker_serviceDesc, srv)
@@ -1764,64 +4827,100 @@ func _Poker_DeleteGames_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poker.Poker/DeleteGames",
+		FullMethod: "/poker.Poker/DeleteGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).DeleteGames(ctx, req.(*Games))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_AllocateGameSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Game)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).AllocateGameSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/AllocateGameSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).AllocateGameSlots(ctx, req.(*Game))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_SetButtonPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Game)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).SetButtonPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/SetButtonPositions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PokerServer).DeleteGames(ctx, req.(*Games))
+		return srv.(PokerServer).SetButtonPositions(ctx, req.(*Game))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Poker_AllocateGameSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Poker_SetMin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Game)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PokerServer).AllocateGameSlots(ctx, in)
+		return srv.(PokerServer).SetMin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poker.Poker/AllocateGameSlots",
+		FullMethod: "/poker.Poker/SetMin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PokerServer).AllocateGameSlots(ctx, req.(*Game))
+		return srv.(PokerServer).SetMin(ctx, req.(*Game))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Poker_SetButtonPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Poker_SetBlinds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Game)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PokerServer).SetButtonPositions(ctx, in)
+		return srv.(PokerServer).SetBlinds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poker.Poker/SetButtonPositions",
+		FullMethod: "/poker.Poker/SetBlinds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PokerServer).SetButtonPositions(ctx, req.(*Game))
+		return srv.(PokerServer).SetBlinds(ctx, req.(*Game))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Poker_SetMin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Poker_SetRake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Game)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PokerServer).SetMin(ctx, in)
+		return srv.(PokerServer).SetRake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/poker.Poker/SetMin",
+		FullMethod: "/poker.Poker/SetRake",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PokerServer).SetMin(ctx, req.(*Game))
+		return srv.(PokerServer).SetRake(ctx, req.(*Game))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1898,6 +4997,45 @@ func _Poker_UpdateGameStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Poker_ListGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).ListGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/ListGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).ListGames(ctx, req.(*ListGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_WatchLobby_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLobbyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServer).WatchLobby(m, &pokerWatchLobbyServer{stream})
+}
+
+type Poker_WatchLobbyServer interface {
+	Send(*LobbyGame) error
+	grpc.ServerStream
+}
+
+type pokerWatchLobbyServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerWatchLobbyServer) Send(m *LobbyGame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Poker_GetGamePlayersByGameId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Game)
 	if err := dec(in); err != nil {
@@ -1952,6 +5090,42 @@ func _Poker_RemovePlayerFromGame_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Poker_GetWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Game)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).GetWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/GetWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).GetWaitlist(ctx, req.(*Game))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waitlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/LeaveWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).LeaveWaitlist(ctx, req.(*Waitlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Poker_GetRoundPlayersByRoundId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Round)
 	if err := dec(in); err != nil {
@@ -2150,6 +5324,24 @@ func _Poker_DealTurn_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Poker_DealStudStreet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).DealStudStreet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/DealStudStreet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).DealStudStreet(ctx, req.(*Round))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Poker_CreateDeck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Round)
 	if err := dec(in); err != nil {
@@ -2438,6 +5630,210 @@ func _Poker_IsBettingOver_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Poker_VerifyRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Round)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).VerifyRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/VerifyRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).VerifyRound(ctx, req.(*Round))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_AgreeToRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunAgreement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).AgreeToRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/AgreeToRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).AgreeToRuns(ctx, req.(*RunAgreement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_GetRakeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RakeReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).GetRakeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/GetRakeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).GetRakeReport(ctx, req.(*RakeReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_ExportHandHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).ExportHandHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/ExportHandHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).ExportHandHistory(ctx, req.(*HandHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_ReplayHand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServer).ReplayHand(m, &pokerReplayHandServer{stream})
+}
+
+type Poker_ReplayHandServer interface {
+	Send(*HandEvent) error
+	grpc.ServerStream
+}
+
+type pokerReplayHandServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerReplayHandServer) Send(m *HandEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Poker_Spectate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpectateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServer).Spectate(m, &pokerSpectateServer{stream})
+}
+
+type Poker_SpectateServer interface {
+	Send(*SpectatorEvent) error
+	grpc.ServerStream
+}
+
+type pokerSpectateServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerSpectateServer) Send(m *SpectatorEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Poker_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).GetPlayerStats(ctx, req.(*PlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Season)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).CreateSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/CreateSeason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).CreateSeason(ctx, req.(*Season))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).GetLeaderboard(ctx, req.(*LeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_CalculateEquity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).CalculateEquity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/CalculateEquity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).CalculateEquity(ctx, req.(*EquityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Poker_CalculateRangeEquity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeEquityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServer).CalculateRangeEquity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.Poker/CalculateRangeEquity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServer).CalculateRangeEquity(ctx, req.(*RangeEquityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Poker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "poker.Poker",
 	HandlerType: (*PokerServer)(nil),
@@ -2502,6 +5898,14 @@ var _Poker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetMin",
 			Handler:    _Poker_SetMin_Handler,
 		},
+		{
+			MethodName: "SetBlinds",
+			Handler:    _Poker_SetBlinds_Handler,
+		},
+		{
+			MethodName: "SetRake",
+			Handler:    _Poker_SetRake_Handler,
+		},
 		{
 			MethodName: "ValidatePreGame",
 			Handler:    _Poker_ValidatePreGame_Handler,
@@ -2518,6 +5922,10 @@ var _Poker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGameStatus",
 			Handler:    _Poker_UpdateGameStatus_Handler,
 		},
+		{
+			MethodName: "ListGames",
+			Handler:    _Poker_ListGames_Handler,
+		},
 		{
 			MethodName: "GetGamePlayersByGameId",
 			Handler:    _Poker_GetGamePlayersByGameId_Handler,
@@ -2530,6 +5938,14 @@ var _Poker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemovePlayerFromGame",
 			Handler:    _Poker_RemovePlayerFromGame_Handler,
 		},
+		{
+			MethodName: "GetWaitlist",
+			Handler:    _Poker_GetWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _Poker_LeaveWaitlist_Handler,
+		},
 		{
 			MethodName: "GetRoundPlayersByRoundId",
 			Handler:    _Poker_GetRoundPlayersByRoundId_Handler,
@@ -2574,6 +5990,10 @@ var _Poker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DealTurn",
 			Handler:    _Poker_DealTurn_Handler,
 		},
+		{
+			MethodName: "DealStudStreet",
+			Handler:    _Poker_DealStudStreet_Handler,
+		},
 		{
 			MethodName: "CreateDeck",
 			Handler:    _Poker_CreateDeck_Handler,
@@ -2638,7 +6058,59 @@ var _Poker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsBettingOver",
 			Handler:    _Poker_IsBettingOver_Handler,
 		},
+		{
+			MethodName: "VerifyRound",
+			Handler:    _Poker_VerifyRound_Handler,
+		},
+		{
+			MethodName: "AgreeToRuns",
+			Handler:    _Poker_AgreeToRuns_Handler,
+		},
+		{
+			MethodName: "GetRakeReport",
+			Handler:    _Poker_GetRakeReport_Handler,
+		},
+		{
+			MethodName: "ExportHandHistory",
+			Handler:    _Poker_ExportHandHistory_Handler,
+		},
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _Poker_GetPlayerStats_Handler,
+		},
+		{
+			MethodName: "CreateSeason",
+			Handler:    _Poker_CreateSeason_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _Poker_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "CalculateEquity",
+			Handler:    _Poker_CalculateEquity_Handler,
+		},
+		{
+			MethodName: "CalculateRangeEquity",
+			Handler:    _Poker_CalculateRangeEquity_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLobby",
+			Handler:       _Poker_WatchLobby_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayHand",
+			Handler:       _Poker_ReplayHand_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Spectate",
+			Handler:       _Poker_Spectate_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobufs/poker.proto",
 }
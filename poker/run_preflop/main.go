@@ -0,0 +1,54 @@
+// run_preflop generates the preflop equity table loaded by the deck package.
+//
+// From the poker directory:
+//
+//	go run ./run_preflop -out deck/preflop_table.go
+//
+// Pass -csv to write the plain CSV instead.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"grpc_texas_holdem/poker/deck"
+)
+
+func main() {
+	iterations := flag.Int("iterations", 1000000, "deals sampled for each class and number of opponents")
+	seed := flag.Int64("seed", 1, "seed for the sampled deals")
+	out := flag.String("out", "deck/preflop_table.go", "file to write, - for stdout")
+	csv := flag.Bool("csv", false, "write the table as CSV instead of go source")
+	flag.Parse()
+
+	table := deck.GeneratePreflopTable(deck.EquityOptions{
+		Iterations: *iterations,
+		Seed:       *seed,
+	})
+
+	buf := &bytes.Buffer{}
+	if err := deck.WritePreflopTable(buf, table); err != nil {
+		log.Fatal(err)
+	}
+	b := buf.Bytes()
+	if !*csv {
+		b = []byte(fmt.Sprintf(`// Code generated by run_preflop; DO NOT EDIT.
+
+package deck
+
+// preflop equity percentages against 1 to %d random hands, %d deals each, seed %d
+const preflopTableCSV = `+"`%s`\n", deck.MaxPreflopOpponents, *iterations, *seed, b))
+	}
+
+	if *out == "-" {
+		os.Stdout.Write(b)
+		return
+	}
+	if err := ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,403 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"grpc_texas_holdem/poker/deck"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// MaxRuns is the most times players can agree to run the board
+const MaxRuns = 4
+
+// boardStreets maps each street of a board game to the next street dealt
+var boardStreets = map[pb.RoundStatus]pb.RoundStatus{
+	pb.RoundStatus_PRE_FLOP: pb.RoundStatus_FLOP,
+	pb.RoundStatus_FLOP:     pb.RoundStatus_RIVER,
+	pb.RoundStatus_RIVER:    pb.RoundStatus_TURN,
+}
+
+// boardCards is the number of board cards dealt on each street
+var boardCards = map[pb.RoundStatus]int{
+	pb.RoundStatus_FLOP:  3,
+	pb.RoundStatus_RIVER: 1,
+	pb.RoundStatus_TURN:  1,
+}
+
+// AgreeToRuns records how many times a player in hand agrees to run the board if everyone is all in.
+// The board is run the fewest times agreed to by the players in hand, once if anyone hasn't agreed.
+func (s *Server) AgreeToRuns(ctx context.Context, in *pb.RunAgreement) (*pb.RunAgreement, error) {
+	if in.GetRuns() < 1 || in.GetRuns() > MaxRuns {
+		return nil, ErrInvalidRuns
+	}
+	r, err := s.getRound(ctx, &pb.Round{Id: in.GetRound()})
+	if err != nil {
+		return nil, err
+	}
+	if isStud(r.GetVariant()) {
+		return nil, ErrUnImplementedLogic
+	}
+	if r.GetStatus() == pb.RoundStatus_OVER {
+		return nil, ErrRoundIsOver
+	}
+	if s.spectators.spectating(r.GetGame(), in.GetPlayer()) {
+		return nil, ErrSpectating
+	}
+
+	var player *pb.Player
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetId() == in.GetPlayer() {
+			player = p
+		}
+	}
+	if player == nil {
+		return nil, ErrPlayerDoesntExist
+	}
+	if !player.GetInHand() {
+		return nil, ErrPlayerNotInHand
+	}
+
+	if err := s.gormDb.Model(&models.RoundPlayers{}).Where("round = ? AND player = ?", in.GetRound(), in.GetPlayer()).Update(
+		"runs", in.GetRuns()).Error; err != nil {
+		return nil, err
+	}
+	if err := s.logEvent(&pb.HandEvent{
+		Game:   r.GetGame(),
+		Round:  r.GetId(),
+		Type:   pb.HandEventType_RUNS,
+		Status: r.GetStatus(),
+		Player: player.GetId(),
+		Slot:   player.GetSlot(),
+		Runs:   in.GetRuns(),
+	}); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// isAllIn returns true when betting is over before the board is complete and no more than one player
+// in hand has chips left to bet, so the rest of the board can be dealt without any more betting
+func isAllIn(r *pb.Round) bool {
+	if isStud(r.GetVariant()) {
+		return false
+	}
+	if _, ok := boardStreets[r.GetStatus()]; !ok {
+		return false
+	}
+	inHand, withChips := 0, 0
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if !p.GetInHand() {
+			continue
+		}
+		inHand++
+		if p.GetChips() > 0 {
+			withChips++
+		}
+	}
+	return inHand > 1 && withChips < 2
+}
+
+// agreedRuns returns the fewest runs agreed to by the players in hand
+func (s *Server) agreedRuns(ctx context.Context, r *pb.Round) (int32, error) {
+	var rows []*models.RoundPlayers
+	if err := s.gormDb.Where("round = ?", r.GetId()).Find(&rows).Error; err != nil {
+		return 0, err
+	}
+	runs := map[int64]int32{}
+	for _, row := range rows {
+		runs[row.Player] = row.Runs
+	}
+
+	agreed := int32(MaxRuns)
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetInHand() && runs[p.GetId()] < agreed {
+			agreed = runs[p.GetId()]
+		}
+	}
+	if agreed < 1 {
+		return 1, nil
+	}
+	return agreed, nil
+}
+
+// runItOut deals the rest of the board once everyone is all in, then settles the round.
+// Each street's equity is recorded for the hand, and when the players agreed to run it more than
+// once the extra boards are dealt from the rest of the deck and the pot is split between them.
+func (s *Server) runItOut(ctx context.Context, r *pb.Round) (*pb.Round, error) {
+	runs, err := s.agreedRuns(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.gormDb.Model(&models.Round{}).Where("id = ?", r.GetId()).Update("all_in", true).Error; err != nil {
+		return nil, err
+	}
+	r.AllIn = true
+
+	start := r.GetStatus()
+	dealt, err := deck.ParseHand(r.GetFlop() + r.GetRiver() + r.GetTurn())
+	if err != nil {
+		return nil, err
+	}
+
+	equities := []*pb.StreetEquity{}
+	e, err := streetEquity(r, 1, start, dealt)
+	if err != nil {
+		return nil, err
+	}
+	equities = append(equities, e...)
+
+	for status := start; status != pb.RoundStatus_TURN; {
+		status = boardStreets[status]
+		r, err = s.dealBoard(ctx, r, status)
+		if err != nil {
+			return nil, err
+		}
+		board, err := deck.ParseHand(r.GetFlop() + r.GetRiver() + r.GetTurn())
+		if err != nil {
+			return nil, err
+		}
+		e, err := streetEquity(r, 1, status, board)
+		if err != nil {
+			return nil, err
+		}
+		equities = append(equities, e...)
+	}
+
+	if runs > 1 {
+		d, err := deck.ParseDeck(r.GetDeck())
+		if err != nil {
+			return nil, err
+		}
+		// every run burns and deals the same streets as the first
+		needed := 0
+		for status := start; status != pb.RoundStatus_TURN; {
+			status = boardStreets[status]
+			needed += 1 + boardCards[status]
+		}
+		boards := []string{r.GetFlop() + r.GetRiver() + r.GetTurn()}
+		for run := int32(2); run <= runs && len(d) >= needed; run++ {
+			board := append(deck.Hand{}, dealt...)
+			for status := start; status != pb.RoundStatus_TURN; {
+				status = boardStreets[status]
+				//burn one
+				_, d = deck.DealCard(d)
+				var c deck.Card
+				for i := 0; i < boardCards[status]; i++ {
+					c, d = deck.DealCard(d)
+					board = append(board, c)
+				}
+				e, err := streetEquity(r, run, status, board)
+				if err != nil {
+					return nil, err
+				}
+				equities = append(equities, e...)
+			}
+			boards = append(boards, board.String())
+		}
+
+		if len(boards) > 1 {
+			// the extra boards are logged whole, after the first board's streets
+			for _, board := range boards[1:] {
+				if err := s.logEvent(&pb.HandEvent{
+					Game:   r.GetGame(),
+					Round:  r.GetId(),
+					Type:   pb.HandEventType_DEAL,
+					Status: pb.RoundStatus_TURN,
+					Cards:  board,
+				}); err != nil {
+					return nil, err
+				}
+			}
+			r.RunBoards = boards
+			r.Deck = d.String()
+			r, err = s.UpdateDeck(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.gormDb.Model(&models.Round{}).Where("id = ?", r.GetId()).Update(
+				"run_boards", strings.Join(boards, ",")).Error; err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, e := range equities {
+		for _, row := range models.StreetEquitiesUnMarshal(r.GetId(), e) {
+			if err := s.gormDb.Create(row).Error; err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	r.Status = pb.RoundStatus_OVER
+	r, err = s.UpdateRoundStatus(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.logEvent(&pb.HandEvent{Game: r.GetGame(), Round: r.GetId(), Type: pb.HandEventType_STREET, Status: r.GetStatus()}); err != nil {
+		return nil, err
+	}
+	r, err = s.getRound(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	r, err = s.EvaluateHands(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateRoundWinner(ctx, r)
+}
+
+// streetEquity calculates the equity of each hand in the round for the board dealt so far.
+// Equity is only calculated for hold'em's high hands, other variants get no equity.
+func streetEquity(r *pb.Round, run int32, status pb.RoundStatus, board deck.Hand) ([]*pb.StreetEquity, error) {
+	if r.GetVariant() != pb.GameVariant_HOLDEM && r.GetVariant() != pb.GameVariant_HOLDEM_HI_LO {
+		return nil, nil
+	}
+
+	players := []*pb.Player{}
+	hands := []deck.Hand{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if !p.GetInHand() {
+			continue
+		}
+		hand, err := deck.ParseHand(p.GetCards())
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, p)
+		hands = append(hands, hand)
+	}
+
+	result, err := deck.CalculateEquity(hands, board, nil, deck.EquityOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.StreetEquity{
+		Run:    run,
+		Status: status,
+		Board:  board.String(),
+	}
+	for i, e := range result.Hands {
+		out.Hands = append(out.Hands, &pb.HandEquity{
+			Player: players[i].GetId(),
+			Hand:   hands[i].String(),
+			Win:    e.Win,
+			Tie:    e.Tie,
+			Equity: e.Equity,
+		})
+	}
+	return []*pb.StreetEquity{out}, nil
+}
+
+// evaluateRuns evaluates the round's hands again for each extra board it was run with
+func (s *Server) evaluateRuns(ctx context.Context, r *pb.Round) ([]*pb.Round, error) {
+	out := []*pb.Round{}
+	for _, b := range r.GetRunBoards()[1:] {
+		board, err := deck.ParseHand(b)
+		if err != nil {
+			return nil, err
+		}
+		if len(board) != 5 {
+			return nil, ErrNotEnoughCards
+		}
+
+		players := proto.Clone(r.GetPlayers()).(*pb.Players)
+		for _, p := range players.GetPlayers() {
+			p.LowScore = 0
+		}
+		run, err := s.EvaluateHands(ctx, &pb.Round{
+			Id:      r.GetId(),
+			Game:    r.GetGame(),
+			Variant: r.GetVariant(),
+			Status:  r.GetStatus(),
+			Flop:    board[:3].String(),
+			River:   board[3:4].String(),
+			Turn:    board[4:].String(),
+			Players: players,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, run)
+	}
+	return out, nil
+}
+
+// sidePot is a share of the chips bet in a round, and the players in hand who can win it
+type sidePot struct {
+	chips    int64
+	eligible map[int64]bool
+}
+
+// contested returns the evaluated round with only the players who can win the pot
+func (sp *sidePot) contested(r *pb.Round) *pb.Round {
+	players := []*pb.Player{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if sp.eligible[p.GetId()] {
+			players = append(players, p)
+		}
+	}
+	return &pb.Round{Players: &pb.Players{Players: players}}
+}
+
+// sidePots splits the chips bet in a round into a main pot every player in hand can win, then a side
+// pot for each bigger stack still in hand which only the players who bet that much can win.
+// A bet no one still in hand called is a side pot of its own, won back by the player who bet it.
+func sidePots(r *pb.Round, bets *pb.Bets) []*sidePot {
+	bet := map[int64]int64{}
+	for _, b := range bets.GetBets() {
+		bet[b.GetPlayer()] += b.GetChips()
+	}
+
+	levels := []int64{}
+	seen := map[int64]bool{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if v := bet[p.GetId()]; p.GetInHand() && !seen[v] {
+			seen[v] = true
+			levels = append(levels, v)
+		}
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+	pots := []*sidePot{}
+	prev := int64(0)
+	for _, level := range levels {
+		sp := &sidePot{eligible: map[int64]bool{}}
+		for _, v := range bet {
+			sp.chips += min64(v, level) - min64(v, prev)
+		}
+		for _, p := range r.GetPlayers().GetPlayers() {
+			if p.GetInHand() && bet[p.GetId()] >= level {
+				sp.eligible[p.GetId()] = true
+			}
+		}
+		prev = level
+		if sp.chips > 0 || len(pots) == 0 {
+			pots = append(pots, sp)
+		}
+	}
+	if len(pots) == 0 {
+		return nil
+	}
+
+	// chips folded players bet over what anyone still in hand bet go in the last pot
+	for _, v := range bet {
+		if v > prev {
+			pots[len(pots)-1].chips += v - prev
+		}
+	}
+	return pots
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+
+	"grpc_texas_holdem/poker/deck"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// MaxEquityIterations is the most boards or deals an equity request can sample
+const MaxEquityIterations = 10 * deck.DefaultEquityIterations
+
+// CalculateEquity calculates the hold'em equity of each hand, see deck.CalculateEquity
+func (s *Server) CalculateEquity(ctx context.Context, in *pb.EquityRequest) (*pb.Equity, error) {
+	if in.GetIterations() > MaxEquityIterations {
+		return nil, ErrTooManyIterations
+	}
+	hands := []deck.Hand{}
+	for _, h := range in.GetHands() {
+		hand, err := deck.ParseHand(h)
+		if err != nil {
+			return nil, err
+		}
+		hands = append(hands, hand)
+	}
+	board, err := deck.ParseHand(in.GetBoard())
+	if err != nil {
+		return nil, err
+	}
+	dead, err := deck.ParseHand(in.GetDead())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := deck.CalculateEquity(hands, board, dead, deck.EquityOptions{
+		Iterations: int(in.GetIterations()),
+		Seed:       in.GetSeed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.Equity{
+		Boards:     int64(result.Boards),
+		Exhaustive: result.Exhaustive,
+	}
+	for i, e := range result.Hands {
+		out.Hands = append(out.Hands, &pb.HandEquity{
+			Hand:   hands[i].String(),
+			Win:    e.Win,
+			Tie:    e.Tie,
+			Equity: e.Equity,
+		})
+	}
+	return out, nil
+}
+
+// CalculateRangeEquity calculates the hold'em equity of each range, see deck.RangeEquity
+func (s *Server) CalculateRangeEquity(ctx context.Context, in *pb.RangeEquityRequest) (*pb.Equity, error) {
+	if in.GetIterations() > MaxEquityIterations {
+		return nil, ErrTooManyIterations
+	}
+	ranges := []deck.Range{}
+	for _, r := range in.GetRanges() {
+		parsed, err := deck.ParseRange(r)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, parsed)
+	}
+	board, err := deck.ParseHand(in.GetBoard())
+	if err != nil {
+		return nil, err
+	}
+	dead, err := deck.ParseHand(in.GetDead())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := deck.RangeEquity(ranges, board, dead, deck.EquityOptions{
+		Iterations: int(in.GetIterations()),
+		Seed:       in.GetSeed(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.Equity{
+		Boards:     int64(result.Boards),
+		Exhaustive: result.Exhaustive,
+	}
+	for i, e := range result.Hands {
+		out.Hands = append(out.Hands, &pb.HandEquity{
+			Hand:   in.GetRanges()[i],
+			Win:    e.Win,
+			Tie:    e.Tie,
+			Equity: e.Equity,
+		})
+	}
+	return out, nil
+}
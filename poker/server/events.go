@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+/*
+	Every round keeps an append only log of what happened in it, so a hand can be
+	replayed after the players, round and deck have moved on to the next hand.
+
+	The round starts with the dealer button, the deck left after the hole cards were
+	dealt and the game's settings, then each player's seat and starting stack, then
+	their hole cards. The settings are kept since a game's blinds and rake can change
+	between hands.
+	Bets, streets, board and stud cards follow as they happen, and the log ends
+	with the cards shown down and the chips each winner was awarded.
+*/
+
+// logEvent appends the event to its round's log and sends it on to the game's spectators
+func (s *Server) logEvent(e *pb.HandEvent) error {
+	var count int64
+	if err := s.gormDb.Model(&models.HandEvent{}).Where("round = ?", e.GetRound()).Count(&count).Error; err != nil {
+		return err
+	}
+	e.Seq = count + 1
+
+	toCreate := &models.HandEvent{}
+	toCreate.ProtoUnMarshal(e)
+	if err := s.gormDb.Create(toCreate).Error; err != nil {
+		return err
+	}
+	s.spectators.publish(toCreate)
+	return nil
+}
+
+// HandEvents returns the round's event log in order. Unlike ReplayHand it doesn't wait
+// for the round to be over, so it's only for use in process, see the resim package
+func (s *Server) HandEvents(ctx context.Context, round int64) ([]*pb.HandEvent, error) {
+	var rows []*models.HandEvent
+	if err := s.gormDb.Where("round = ?", round).Order("seq").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := []*pb.HandEvent{}
+	for _, row := range rows {
+		out = append(out, row.ProtoMarshal())
+	}
+	return out, nil
+}
+
+// ReplayHand streams a finished round's event log. With a speed set the events are spaced
+// out as they were when the hand was played, sped up by the speed.
+// The log has every player's hole cards, so hands can't be replayed until they're over.
+func (s *Server) ReplayHand(in *pb.ReplayRequest, stream pb.Poker_ReplayHandServer) error {
+	r := &models.Round{}
+	if err := s.gormDb.Where("id = ?", in.GetRound()).Find(r).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return err
+	} else if err == gorm.ErrRecordNotFound {
+		return ErrGameDoesntExist
+	}
+	if r.Status != pb.RoundStatus_OVER.String() {
+		return ErrRoundNotOver
+	}
+
+	events, err := s.HandEvents(stream.Context(), int64(r.ID))
+	if err != nil {
+		return err
+	}
+	for i, e := range events {
+		if in.GetSpeed() > 0 && i > 0 {
+			wait := time.Duration(float64(e.GetTime()-events[i-1].GetTime())/in.GetSpeed()) * time.Millisecond
+			select {
+			case <-time.After(wait):
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			}
+		}
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -13,8 +13,27 @@ var (
 	ErrDealerNotSet           = fmt.Errorf("dealer not set")
 	ErrPlayerNotSet           = fmt.Errorf("player not set")
 	ErrNoPlayerInHand         = fmt.Errorf("No Player in hand left of start")
+	ErrInvalidSlot            = fmt.Errorf("player slot is taken or not a seat at the table")
 )
 
+const (
+	// DefaultSeats is the size of a table when a game doesn't set one
+	DefaultSeats = 8
+	// MaxSeats is the size of the largest table
+	MaxSeats = 10
+)
+
+// TableSizes are the number of seats a table can have
+var TableSizes = map[int64]bool{2: true, 6: true, 8: true, 9: true, 10: true}
+
+// Seats returns the number of seats at a game's table
+func Seats(g *pb.Game) int64 {
+	if g.GetMaxSeats() == 0 {
+		return DefaultSeats
+	}
+	return g.GetMaxSeats()
+}
+
 // use a game ring to manage turns
 type GameRing struct {
 	*ring.Ring
@@ -37,11 +56,22 @@ NewRing generates a ring data type using the players in a game.
 	Doing a server.GetGame() on a valid game should give the necessary info to generate a ring and start a game.
 	The server.ValidateGame() call can help determine if a game has the required info to generate a ring.
 
+	Seats can be empty, the ring only has the seated players in seat order. The dealer
+	button stays on its seat, so when the dealer's seat is empty the player in the next
+	seat round the table deals.
+
 	Note: there is an edge case where if it is heads up (2 players) the blinds would be reversed
 */
 func NewRing(g *pb.Game) (*GameRing, error) {
 	// construct game ring:
 	players := g.GetPlayers().GetPlayers()
+	taken := map[int64]bool{}
+	for _, p := range players {
+		if p.GetSlot() < 1 || p.GetSlot() > Seats(g) || taken[p.GetSlot()] {
+			return nil, ErrInvalidSlot
+		}
+		taken[p.GetSlot()] = true
+	}
 	r := ring.New(len(players))
 	gr := &GameRing{
 		Ring: r,
@@ -91,7 +121,13 @@ func (g *GameRing) LeftOfDealer() (*pb.Player, error) {
 	return pl, nil
 }
 
-func (g *GameRing) NextInHand(start *pb.Player) (*pb.Player, error) {
+// canAct returns true if the player is in hand and has chips left to bet, players all in have nothing to act with
+func canAct(p *pb.Player) bool {
+	return p.GetInHand() && p.GetChips() > 0
+}
+
+// NextToAct returns the next player round the table from start that can still act
+func (g *GameRing) NextToAct(start *pb.Player) (*pb.Player, error) {
 
 	g.next()
 	pl, err := g.player()
@@ -101,17 +137,19 @@ func (g *GameRing) NextInHand(start *pb.Player) (*pb.Player, error) {
 
 	if start.GetId() == pl.GetId() {
 		// we have returned to the first player we started with,
-		// that means there are no players in hand
+		// that means there are no other players that can act
 		return nil, ErrNoPlayerInHand
 	}
 
-	if pl.GetInHand() {
+	if canAct(pl) {
 		return pl, err
 	}
 
-	return g.NextInHand(start)
+	return g.NextToAct(start)
 }
-func (g *GameRing) FirstOnBet() (*pb.Player, error) {
+
+// FirstToAct returns the first player left of the dealer that can still act
+func (g *GameRing) FirstToAct() (*pb.Player, error) {
 	if _, err := g.LeftOfDealer(); err != nil {
 		return nil, err
 	}
@@ -120,27 +158,58 @@ func (g *GameRing) FirstOnBet() (*pb.Player, error) {
 		return nil, err
 	}
 
-	if pl.GetInHand() {
+	if canAct(pl) {
 		return pl, err
 	}
-	return g.NextInHand(pl)
+	return g.NextToAct(pl)
+}
+
+// InHandFromLeftOfDealer returns the players still in hand in the order they act,
+// starting with the player left of the dealer
+func (g *GameRing) InHandFromLeftOfDealer() ([]*pb.Player, error) {
+	if _, err := g.LeftOfDealer(); err != nil {
+		return nil, err
+	}
+	out := []*pb.Player{}
+	for i := 0; i < g.Len(); i++ {
+		pl, err := g.player()
+		if err != nil {
+			return nil, err
+		}
+		if pl.GetInHand() {
+			out = append(out, pl)
+		}
+		g.next()
+	}
+	if len(out) < 1 {
+		return nil, ErrNoPlayerInHand
+	}
+	return out, nil
 }
 
 func (g *GameRing) CurrentDealer() (*pb.Player, error) {
+	if g.GetDealer() < 1 || g.GetDealer() > Seats(g.Game) {
+		return nil, ErrDealerNotSet
+	}
 
+	// the first seated player from the dealer's seat round the table
+	seats := Seats(g.Game)
+	dealer := int64(-1)
 	for i := 0; i < g.Len(); i++ {
-
 		player, ok := g.Value.(*pb.Player)
 		if !ok {
 			return nil, ErrIncorrectRingValueType
 		}
-		if player.GetSlot() == g.GetDealer() {
-			return player, nil
+		distance := (player.GetSlot() - g.GetDealer() + seats) % seats
+		if dealer < 0 || distance < (dealer-g.GetDealer()+seats)%seats {
+			dealer = player.GetSlot()
 		}
 		g.next()
 	}
-	return nil, ErrDealerNotSet
-
+	if dealer < 0 {
+		return nil, ErrDealerNotSet
+	}
+	return g.GetPlayerFromSlot(&pb.Player{Slot: dealer})
 }
 
 func (g *GameRing) CurrentBigBlind() error {
@@ -0,0 +1,606 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"grpc_texas_holdem/poker/deck"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+	"grpc_texas_holdem/poker/server/game_ring"
+)
+
+/*
+	Hand histories in the PokerStars text format, so hands can be loaded into tracking
+	and replay tools.
+
+	Players are updated in place as the game goes on, so the seats, starting stacks and
+	hole cards are snapshotted on the round players when the round starts, and the
+	final cards and chips won when the pot is settled. The actions come from the bets.
+*/
+
+var historyVariants = map[pb.GameVariant]string{
+	pb.GameVariant_HOLDEM:          "Hold'em",
+	pb.GameVariant_OMAHA:           "Omaha",
+	pb.GameVariant_HOLDEM_HI_LO:    "Hold'em Hi/Lo",
+	pb.GameVariant_OMAHA_HI_LO:     "Omaha Hi/Lo",
+	pb.GameVariant_SHORT_DECK:      "6+ Hold'em",
+	pb.GameVariant_SEVEN_CARD_STUD: "7 Card Stud",
+	pb.GameVariant_RAZZ:            "Razz",
+}
+
+var historyLimits = map[pb.BetLimit]string{
+	pb.BetLimit_NO_LIMIT:  "No Limit",
+	pb.BetLimit_POT_LIMIT: "Pot Limit",
+}
+
+// the RIVER status deals the 4th board card and TURN the 5th, so they're named by the card dealt
+var historyStreets = map[pb.RoundStatus]string{
+	pb.RoundStatus_PRE_FLOP:       "HOLE CARDS",
+	pb.RoundStatus_FLOP:           "FLOP",
+	pb.RoundStatus_RIVER:          "TURN",
+	pb.RoundStatus_TURN:           "RIVER",
+	pb.RoundStatus_THIRD_STREET:   "3rd STREET",
+	pb.RoundStatus_FOURTH_STREET:  "4th STREET",
+	pb.RoundStatus_FIFTH_STREET:   "5th STREET",
+	pb.RoundStatus_SIXTH_STREET:   "6th STREET",
+	pb.RoundStatus_SEVENTH_STREET: "RIVER",
+}
+
+var historyFolds = map[pb.RoundStatus]string{
+	pb.RoundStatus_PRE_FLOP:       "before Flop",
+	pb.RoundStatus_FLOP:           "on the Flop",
+	pb.RoundStatus_RIVER:          "on the Turn",
+	pb.RoundStatus_TURN:           "on the River",
+	pb.RoundStatus_THIRD_STREET:   "on 3rd St.",
+	pb.RoundStatus_FOURTH_STREET:  "on 4th St.",
+	pb.RoundStatus_FIFTH_STREET:   "on 5th St.",
+	pb.RoundStatus_SIXTH_STREET:   "on 6th St.",
+	pb.RoundStatus_SEVENTH_STREET: "on the River",
+}
+
+var boardStreetOrder = []pb.RoundStatus{
+	pb.RoundStatus_PRE_FLOP, pb.RoundStatus_FLOP, pb.RoundStatus_RIVER, pb.RoundStatus_TURN,
+}
+
+var studStreetOrder = []pb.RoundStatus{
+	pb.RoundStatus_THIRD_STREET, pb.RoundStatus_FOURTH_STREET, pb.RoundStatus_FIFTH_STREET,
+	pb.RoundStatus_SIXTH_STREET, pb.RoundStatus_SEVENTH_STREET,
+}
+
+// snapshotRound records each player's seat, stack and cards as the round starts
+func (s *Server) snapshotRound(ctx context.Context, r *pb.Round, g *pb.Game) error {
+	r, err := s.getRound(ctx, r)
+	if err != nil {
+		return err
+	}
+	if err := s.logEvent(&pb.HandEvent{
+		Game:   r.GetGame(),
+		Round:  r.GetId(),
+		Type:   pb.HandEventType_START,
+		Status: r.GetStatus(),
+		Slot:   g.GetDealer(),
+		Deck:   r.GetDeck(),
+		Settings: &pb.Game{
+			Min:          g.GetMin(),
+			SmallBlind:   g.GetSmallBlind(),
+			BigBlind:     g.GetBigBlind(),
+			MinBet:       g.GetMinBet(),
+			RakePercent:  g.GetRakePercent(),
+			RakeCap:      g.GetRakeCap(),
+			NoFlopNoDrop: g.GetNoFlopNoDrop(),
+			Variant:      g.GetVariant(),
+			Limit:        g.GetLimit(),
+			Training:     g.GetTraining(),
+			MaxSeats:     g.GetMaxSeats(),
+		},
+	}); err != nil {
+		return err
+	}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if err := s.gormDb.Model(&models.RoundPlayers{}).Where("round = ? AND player = ?", r.GetId(), p.GetId()).Updates(map[string]interface{}{
+			"slot":     p.GetSlot(),
+			"chips":    p.GetChips(),
+			"cards":    p.GetCards(),
+			"up_cards": p.GetUpCards(),
+		}).Error; err != nil {
+			return err
+		}
+		if err := s.logEvent(&pb.HandEvent{
+			Game:   r.GetGame(),
+			Round:  r.GetId(),
+			Type:   pb.HandEventType_SEAT,
+			Status: r.GetStatus(),
+			Player: p.GetId(),
+			Slot:   p.GetSlot(),
+			Chips:  p.GetChips(),
+		}); err != nil {
+			return err
+		}
+	}
+	// the hole cards are logged once everyone is seated
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if err := s.logEvent(&pb.HandEvent{
+			Game:    r.GetGame(),
+			Round:   r.GetId(),
+			Type:    pb.HandEventType_DEAL,
+			Status:  r.GetStatus(),
+			Player:  p.GetId(),
+			Slot:    p.GetSlot(),
+			Cards:   p.GetCards(),
+			UpCards: p.GetUpCards(),
+		}); err != nil {
+			return err
+		}
+	}
+	return s.gormDb.Model(&models.Round{}).Where("id = ?", r.GetId()).Update("dealer", g.GetDealer()).Error
+}
+
+// recordAwards records the chips each player won and their final cards, stud cards are dealt after the snapshot.
+// The evaluated hands of players who showed down are kept so the settled round still has them, see getRound
+func (s *Server) recordAwards(ctx context.Context, r *pb.Round, awards map[int64]int64) error {
+	// the players left in the hand show their cards down when there's more than one
+	showdown := []*pb.Player{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetInHand() {
+			showdown = append(showdown, p)
+		}
+	}
+	for _, p := range showdown {
+		if len(showdown) < 2 {
+			continue
+		}
+		if err := s.logEvent(&pb.HandEvent{
+			Game:    r.GetGame(),
+			Round:   r.GetId(),
+			Type:    pb.HandEventType_SHOWDOWN,
+			Status:  r.GetStatus(),
+			Player:  p.GetId(),
+			Slot:    p.GetSlot(),
+			Cards:   p.GetCards(),
+			UpCards: p.GetUpCards(),
+		}); err != nil {
+			return err
+		}
+		if err := s.gormDb.Model(&models.RoundPlayers{}).Where("round = ? AND player = ?", r.GetId(), p.GetId()).Updates(map[string]interface{}{
+			"hand_category": p.GetHandCategory().String(),
+			"hand_name":     p.GetHandName(),
+			"best_hand":     p.GetBestHand(),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if err := s.gormDb.Model(&models.RoundPlayers{}).Where("round = ? AND player = ?", r.GetId(), p.GetId()).Updates(map[string]interface{}{
+			"cards":    p.GetCards(),
+			"up_cards": p.GetUpCards(),
+			"won":      awards[p.GetId()],
+		}).Error; err != nil {
+			return err
+		}
+		if awards[p.GetId()] == 0 {
+			continue
+		}
+		if err := s.logEvent(&pb.HandEvent{
+			Game:   r.GetGame(),
+			Round:  r.GetId(),
+			Type:   pb.HandEventType_SETTLE,
+			Status: r.GetStatus(),
+			Player: p.GetId(),
+			Slot:   p.GetSlot(),
+			Chips:  awards[p.GetId()],
+			Cards:  p.GetCards(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportHandHistory writes a finished round, or every finished round of a game, as PokerStars hand histories
+func (s *Server) ExportHandHistory(ctx context.Context, in *pb.HandHistoryRequest) (*pb.HandHistory, error) {
+	var rounds []*models.Round
+	if in.GetRound() != 0 {
+		r := &models.Round{}
+		if err := s.gormDb.Where("id = ?", in.GetRound()).Find(r).Error; err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		} else if err == gorm.ErrRecordNotFound {
+			return nil, ErrGameDoesntExist
+		}
+		if r.Status != pb.RoundStatus_OVER.String() {
+			return nil, ErrRoundNotOver
+		}
+		rounds = append(rounds, r)
+	} else {
+		if _, err := s.GetGame(ctx, &pb.Game{Id: in.GetGame()}); err != nil {
+			return nil, err
+		}
+		if err := s.gormDb.Where("game = ? AND status = ?", in.GetGame(), pb.RoundStatus_OVER.String()).Order("id").Find(&rounds).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	out := &pb.HandHistory{}
+	hands := []string{}
+	for _, r := range rounds {
+		h, err := s.handHistory(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		hands = append(hands, h.String())
+		out.Rounds = append(out.Rounds, int64(r.ID))
+	}
+	out.Text = strings.Join(hands, "\n\n")
+	return out, nil
+}
+
+// historySeat is a player as they were in the round
+type historySeat struct {
+	player *pb.Player
+	slot   int64
+	chips  int64
+	won    int64
+	// chips put in the pot over the whole round
+	put    int64
+	folded bool
+	foldOn pb.RoundStatus
+	blind  pb.Bet_BetType
+}
+
+type handHistory struct {
+	round   *pb.Round
+	game    *pb.Game
+	created time.Time
+	seats   []*historySeat
+	bets    []*pb.Bet
+	rake    int64
+}
+
+// handHistory loads everything needed to write a finished round
+func (s *Server) handHistory(ctx context.Context, r *models.Round) (*handHistory, error) {
+	game, err := s.GetGame(ctx, &pb.Game{Id: r.Game})
+	if err != nil {
+		return nil, err
+	}
+	h := &handHistory{round: r.ProtoMarshal(), game: game, created: r.CreatedAt}
+
+	var rows []*models.RoundPlayers
+	if err := s.gormDb.Where("round = ?", r.ID).Order("slot, player").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	seats := map[int64]*historySeat{}
+	for _, row := range rows {
+		p, err := s.GetPlayer(ctx, &pb.Player{Id: row.Player})
+		if err != nil {
+			return nil, err
+		}
+		seat := &historySeat{
+			player: &pb.Player{Id: p.GetId(), Name: p.GetName(), Cards: row.Cards, UpCards: row.UpCards, InHand: true},
+			slot:   row.Slot,
+			chips:  row.Chips,
+			won:    row.Won,
+		}
+		seats[row.Player] = seat
+		h.seats = append(h.seats, seat)
+	}
+
+	var bets []*models.Bet
+	if err := s.gormDb.Where("round = ?", r.ID).Order("id").Find(&bets).Error; err != nil {
+		return nil, err
+	}
+	for _, b := range bets {
+		bet := b.ProtoMarshal()
+		h.bets = append(h.bets, bet)
+		seat, ok := seats[bet.GetPlayer()]
+		if !ok {
+			continue
+		}
+		seat.put += bet.GetChips()
+		switch bet.GetType() {
+		case pb.Bet_FOLD:
+			seat.folded = true
+			seat.foldOn = bet.GetStatus()
+			seat.player.InHand = false
+		case pb.Bet_SMALL, pb.Bet_BIG:
+			seat.blind = bet.GetType()
+		}
+	}
+
+	var rakes []*models.Rake
+	if err := s.gormDb.Where("round = ?", r.ID).Find(&rakes).Error; err != nil {
+		return nil, err
+	}
+	for _, rake := range rakes {
+		h.rake += rake.Chips
+	}
+
+	// describe the hands at showdown from the snapshotted cards
+	if showdown := h.showdown(); len(showdown) > 1 {
+		eval := &pb.Round{
+			Variant: h.round.GetVariant(),
+			Flop:    h.round.GetFlop(),
+			River:   h.round.GetRiver(),
+			Turn:    h.round.GetTurn(),
+			Players: &pb.Players{Players: showdown},
+		}
+		if _, err := s.EvaluateHands(ctx, eval); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// showdown returns the players that didn't fold, as long as all their cards were recorded
+func (h *handHistory) showdown() []*pb.Player {
+	out := []*pb.Player{}
+	for _, seat := range h.seats {
+		if seat.folded {
+			continue
+		}
+		if seat.player.GetCards() == "" {
+			return nil
+		}
+		out = append(out, seat.player)
+	}
+	return out
+}
+
+func (h *handHistory) stud() bool {
+	return isStud(h.round.GetVariant())
+}
+
+func (h *handHistory) String() string {
+	b := &strings.Builder{}
+	small, big := gameBlinds(h.game)
+	for _, bet := range h.bets {
+		switch bet.GetType() {
+		case pb.Bet_SMALL:
+			small = bet.GetChips()
+		case pb.Bet_BIG:
+			big = bet.GetChips()
+		}
+	}
+	fmt.Fprintf(b, "PokerStars Hand #%d: %s %s (%d/%d) - %s\n", h.round.GetId(),
+		historyVariants[h.round.GetVariant()], historyLimits[betLimit(h.game)], small, big,
+		h.created.UTC().Format("2006/01/02 15:04:05 UTC"))
+	if h.stud() {
+		fmt.Fprintf(b, "Table '%s' %d-max\n", h.game.GetName(), game_ring.Seats(h.game))
+	} else {
+		fmt.Fprintf(b, "Table '%s' %d-max Seat #%d is the button\n", h.game.GetName(), game_ring.Seats(h.game), h.round.GetDealer())
+	}
+	for _, seat := range h.seats {
+		fmt.Fprintf(b, "Seat %d: %s (%d in chips)\n", seat.slot, seat.player.GetName(), seat.chips)
+	}
+
+	streets := boardStreetOrder
+	if h.stud() {
+		streets = studStreetOrder
+	}
+	stacks := map[int64]int64{}
+	for _, seat := range h.seats {
+		stacks[seat.player.GetId()] = seat.chips
+	}
+	for i, status := range streets {
+		bets := []*pb.Bet{}
+		for _, bet := range h.bets {
+			if bet.GetStatus() == status {
+				bets = append(bets, bet)
+			}
+		}
+		if !h.dealt(i) {
+			break
+		}
+		h.writeStreet(b, i, status, bets, stacks)
+	}
+
+	h.writeShowdown(b)
+	h.writeSummary(b)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// dealt returns true if the cards of the ith street were dealt
+func (h *handHistory) dealt(i int) bool {
+	if i == 0 {
+		return true
+	}
+	if h.stud() {
+		for _, seat := range h.seats {
+			if len(seat.player.GetCards()) >= 2*(i+3) {
+				return true
+			}
+		}
+		return false
+	}
+	return len(h.board()) >= 2*(i+2)
+}
+
+func (h *handHistory) board() string {
+	return h.round.GetFlop() + h.round.GetRiver() + h.round.GetTurn()
+}
+
+func (h *handHistory) name(id int64) string {
+	for _, seat := range h.seats {
+		if seat.player.GetId() == id {
+			return seat.player.GetName()
+		}
+	}
+	return fmt.Sprintf("Player %d", id)
+}
+
+func (h *handHistory) writeStreet(b *strings.Builder, i int, status pb.RoundStatus, bets []*pb.Bet, stacks map[int64]int64) {
+	board := splitCards(h.board())
+	if !h.stud() && status == pb.RoundStatus_PRE_FLOP {
+		// the blinds are posted before the cards are dealt
+		rest := []*pb.Bet{}
+		for _, bet := range bets {
+			if bet.GetType() == pb.Bet_SMALL || bet.GetType() == pb.Bet_BIG {
+				stacks[bet.GetPlayer()] -= bet.GetChips()
+				fmt.Fprintf(b, "%s: %s\n", h.name(bet.GetPlayer()), actionText(bet, 0, bet.GetChips(), stacks[bet.GetPlayer()]))
+			} else {
+				rest = append(rest, bet)
+			}
+		}
+		bets = rest
+	}
+
+	switch {
+	case h.stud():
+		fmt.Fprintf(b, "*** %s ***\n", historyStreets[status])
+		for _, seat := range h.seats {
+			cards := splitCards(seat.player.GetCards())
+			if len(cards) < i+3 {
+				continue
+			}
+			if i == 0 {
+				fmt.Fprintf(b, "Dealt to %s [%s]\n", seat.player.GetName(), strings.Join(cards[:3], " "))
+			} else {
+				fmt.Fprintf(b, "Dealt to %s [%s] [%s]\n", seat.player.GetName(), strings.Join(cards[:i+2], " "), cards[i+2])
+			}
+		}
+	case status == pb.RoundStatus_PRE_FLOP:
+		fmt.Fprintf(b, "*** %s ***\n", historyStreets[status])
+		for _, seat := range h.seats {
+			if seat.player.GetCards() != "" {
+				fmt.Fprintf(b, "Dealt to %s [%s]\n", seat.player.GetName(), strings.Join(splitCards(seat.player.GetCards()), " "))
+			}
+		}
+	case status == pb.RoundStatus_FLOP:
+		fmt.Fprintf(b, "*** FLOP *** [%s]\n", strings.Join(board[:3], " "))
+	default:
+		fmt.Fprintf(b, "*** %s *** [%s] [%s]\n", historyStreets[status], strings.Join(board[:i+1], " "), board[i+1])
+	}
+
+	// the blinds, or the bring in, count toward the first street's bet
+	put := map[int64]int64{}
+	highest := int64(0)
+	for _, bet := range h.bets {
+		if bet.GetStatus() == status && (bet.GetType() == pb.Bet_SMALL || bet.GetType() == pb.Bet_BIG) {
+			put[bet.GetPlayer()] += bet.GetChips()
+			if put[bet.GetPlayer()] > highest {
+				highest = put[bet.GetPlayer()]
+			}
+		}
+	}
+	for _, bet := range bets {
+		stacks[bet.GetPlayer()] -= bet.GetChips()
+		put[bet.GetPlayer()] += bet.GetChips()
+		fmt.Fprintf(b, "%s: %s\n", h.name(bet.GetPlayer()), actionText(bet, highest, put[bet.GetPlayer()], stacks[bet.GetPlayer()]))
+		if put[bet.GetPlayer()] > highest {
+			highest = put[bet.GetPlayer()]
+		}
+	}
+}
+
+// actionText describes a bet given the most anyone had put in on the street before it,
+// the player's total on the street after it and what they have left
+func actionText(bet *pb.Bet, highest, total, stack int64) string {
+	var out string
+	switch bet.GetType() {
+	case pb.Bet_FOLD:
+		return "folds"
+	case pb.Bet_SMALL:
+		out = fmt.Sprintf("posts small blind %d", bet.GetChips())
+	case pb.Bet_BIG:
+		out = fmt.Sprintf("posts big blind %d", bet.GetChips())
+	case pb.Bet_BRING_IN:
+		out = fmt.Sprintf("brings in for %d", bet.GetChips())
+	case pb.Bet_CALL:
+		if bet.GetChips() == 0 {
+			return "checks"
+		}
+		out = fmt.Sprintf("calls %d", bet.GetChips())
+	case pb.Bet_RAISE:
+		if highest == 0 {
+			out = fmt.Sprintf("bets %d", bet.GetChips())
+		} else {
+			out = fmt.Sprintf("raises %d to %d", total-highest, total)
+		}
+	default:
+		return bet.GetType().String()
+	}
+	if stack == 0 {
+		out += " and is all-in"
+	}
+	return out
+}
+
+func (h *handHistory) writeShowdown(b *strings.Builder) {
+	showdown := h.showdown()
+	if len(showdown) > 1 {
+		b.WriteString("*** SHOW DOWN ***\n")
+		for _, p := range showdown {
+			fmt.Fprintf(b, "%s: shows [%s] (%s)\n", p.GetName(), strings.Join(splitCards(p.GetCards()), " "), p.GetHandName())
+		}
+	}
+	for _, seat := range h.seats {
+		if seat.won > 0 {
+			fmt.Fprintf(b, "%s collected %d from pot\n", seat.player.GetName(), seat.won)
+		}
+	}
+	if len(showdown) == 1 {
+		fmt.Fprintf(b, "%s: doesn't show hand\n", showdown[0].GetName())
+	}
+}
+
+func (h *handHistory) writeSummary(b *strings.Builder) {
+	pot := int64(0)
+	for _, bet := range h.bets {
+		pot += bet.GetChips()
+	}
+	b.WriteString("*** SUMMARY ***\n")
+	fmt.Fprintf(b, "Total pot %d | Rake %d\n", pot, h.rake)
+	if board := h.board(); board != "" {
+		fmt.Fprintf(b, "Board [%s]\n", strings.Join(splitCards(board), " "))
+	}
+
+	showdown := len(h.showdown()) > 1
+	for _, seat := range h.seats {
+		fmt.Fprintf(b, "Seat %d: %s", seat.slot, seat.player.GetName())
+		if !h.stud() && seat.slot == h.round.GetDealer() {
+			b.WriteString(" (button)")
+		}
+		switch seat.blind {
+		case pb.Bet_SMALL:
+			b.WriteString(" (small blind)")
+		case pb.Bet_BIG:
+			b.WriteString(" (big blind)")
+		}
+
+		cards := strings.Join(splitCards(seat.player.GetCards()), " ")
+		with := ""
+		if seat.player.GetHandName() != "" {
+			with = " with " + seat.player.GetHandName()
+		}
+		switch {
+		case seat.folded:
+			fmt.Fprintf(b, " folded %s", historyFolds[seat.foldOn])
+			if seat.put == 0 {
+				b.WriteString(" (didn't bet)")
+			}
+		case showdown && seat.won > 0:
+			fmt.Fprintf(b, " showed [%s] and won (%d)%s", cards, seat.won, with)
+		case showdown:
+			fmt.Fprintf(b, " showed [%s] and lost%s", cards, with)
+		case seat.won > 0:
+			fmt.Fprintf(b, " collected (%d)", seat.won)
+		}
+		b.WriteString("\n")
+	}
+}
+
+// splitCards splits a card string such as "AhKh" into each card
+func splitCards(cards string) []string {
+	hand, err := deck.ParseHand(cards)
+	if err != nil {
+		return nil
+	}
+	out := []string{}
+	for _, c := range hand {
+		out = append(out, c.String())
+	}
+	return out
+}
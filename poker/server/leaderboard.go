@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+/*
+	Leaderboards rank players over a season.
+
+	Each settled hand adds to a standing for every season it falls in, one per player and
+	variant, so boards are read from the standings rather than by going over the hands.
+	A season created after its start is caught up from the hands already settled in it.
+	A board for every variant sums a player's standings.
+
+	Points are a stand in until there are tournaments to award them, a player gets a
+	point for each player in a hand they finished ahead of on net chips.
+*/
+
+const (
+	defaultLeaderboardPageSize = 25
+	maxLeaderboardPageSize     = 100
+)
+
+var leaderboardOrders = map[pb.LeaderboardRanking]string{
+	pb.LeaderboardRanking_NET:    "net DESC, player",
+	pb.LeaderboardRanking_HANDS:  "hands DESC, player",
+	pb.LeaderboardRanking_POINTS: "points DESC, player",
+}
+
+// CreateSeason creates a season to rank hands settled from its start and before its end,
+// adding the hands already settled in it to its standings
+func (s *Server) CreateSeason(ctx context.Context, in *pb.Season) (*pb.Season, error) {
+	if in.GetName() == "" {
+		return nil, ErrEmptySeasonName
+	}
+	if in.GetStart() >= in.GetEnd() {
+		return nil, ErrInvalidDateRange
+	}
+
+	exists := &models.Season{}
+	if err := s.gormDb.Where("name = ?", in.GetName()).First(exists).Error; err == nil {
+		return nil, ErrSeasonNameExists
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	season := &models.Season{}
+	season.ProtoUnMarshal(in)
+	if err := s.gormDb.Create(season).Error; err != nil {
+		return nil, err
+	}
+
+	var hands []*models.PlayerHand
+	if err := s.gormDb.Where("created_at >= ? AND created_at < ?", season.Start, season.End).Order("round, id").Find(&hands).Error; err != nil {
+		return nil, err
+	}
+	rounds := map[int64][]*models.PlayerHand{}
+	ids := []int64{}
+	for _, h := range hands {
+		if _, ok := rounds[h.Round]; !ok {
+			ids = append(ids, h.Round)
+		}
+		rounds[h.Round] = append(rounds[h.Round], h)
+	}
+	var settled []*models.Round
+	if err := s.gormDb.Where("id in (?)", ids).Order("id").Find(&settled).Error; err != nil {
+		return nil, err
+	}
+	for _, r := range settled {
+		if err := s.addStandings([]*models.Season{season}, r.Variant, rounds[int64(r.ID)]); err != nil {
+			return nil, err
+		}
+	}
+	return season.ProtoMarshal(), nil
+}
+
+// updateStandings adds a settled round's player hands to the standings of the seasons it falls in
+func (s *Server) updateStandings(ctx context.Context, r *pb.Round, hands []*models.PlayerHand) error {
+	if len(hands) == 0 {
+		return nil
+	}
+	settled := hands[0].CreatedAt
+
+	var seasons []*models.Season
+	if err := s.gormDb.Where(`"start" <= ? AND "end" > ?`, settled, settled).Find(&seasons).Error; err != nil {
+		return err
+	}
+	return s.addStandings(seasons, r.GetVariant().String(), hands)
+}
+
+// addStandings adds a round's player hands to each season's standings for the variant
+func (s *Server) addStandings(seasons []*models.Season, variant string, hands []*models.PlayerHand) error {
+	for _, season := range seasons {
+		for _, h := range hands {
+			// finishing ahead of a player in the hand is worth a point
+			points := int64(0)
+			for _, other := range hands {
+				if h.Net > other.Net {
+					points++
+				}
+			}
+
+			standing := &models.Standing{
+				Season:  int64(season.ID),
+				Player:  h.Player,
+				Variant: variant,
+			}
+			if err := s.gormDb.Where(standing).FirstOrCreate(standing).Error; err != nil {
+				return err
+			}
+			if err := s.gormDb.Model(standing).UpdateColumns(map[string]interface{}{
+				"net":    gorm.Expr("net + ?", h.Net),
+				"hands":  gorm.Expr("hands + ?", 1),
+				"points": gorm.Expr("points + ?", points),
+			}).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetLeaderboard gets a page of a season's players ranked by their net chips, hands played or points
+func (s *Server) GetLeaderboard(ctx context.Context, in *pb.LeaderboardRequest) (*pb.Leaderboard, error) {
+	season := &models.Season{}
+	if err := s.gormDb.Where("id = ?", in.GetSeason()).First(season).Error; err == gorm.ErrRecordNotFound {
+		return nil, ErrSeasonDoesntExist
+	} else if err != nil {
+		return nil, err
+	}
+
+	size := int(in.GetPageSize())
+	if size <= 0 {
+		size = defaultLeaderboardPageSize
+	} else if size > maxLeaderboardPageSize {
+		size = maxLeaderboardPageSize
+	}
+	offset := int(in.GetPage()) * size
+
+	q := s.gormDb.Model(&models.Standing{}).Where("season = ?", season.ID)
+	if in.GetByVariant() {
+		q = q.Where("variant = ?", in.GetVariant().String())
+	}
+
+	out := &pb.Leaderboard{Season: int64(season.ID)}
+	if err := q.Select("COUNT(DISTINCT player)").Row().Scan(&out.Total); err != nil {
+		return nil, err
+	}
+
+	var standings []*models.Standing
+	if err := q.Select("player, SUM(net) AS net, SUM(hands) AS hands, SUM(points) AS points").
+		Group("player").
+		Order(leaderboardOrders[in.GetRanking()]).
+		Offset(offset).
+		Limit(size).
+		Scan(&standings).Error; err != nil {
+		return nil, err
+	}
+
+	ids := &pb.Players{}
+	for _, st := range standings {
+		ids.Players = append(ids.Players, &pb.Player{Id: st.Player})
+	}
+	players, err := s.GetPlayers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	names := map[int64]string{}
+	for _, p := range players.GetPlayers() {
+		names[p.GetId()] = p.GetName()
+	}
+
+	for i, st := range standings {
+		out.Standings = append(out.Standings, &pb.Standing{
+			Rank:   int64(offset + i + 1),
+			Player: st.Player,
+			Name:   names[st.Player],
+			Net:    st.Net,
+			Hands:  st.Hands,
+			Points: st.Points,
+		})
+	}
+	return out, nil
+}
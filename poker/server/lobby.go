@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/metadata"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+	"grpc_texas_holdem/poker/server/game_ring"
+)
+
+/*
+	The lobby lists the games to join.
+
+	Watchers of the lobby are pushed a game whenever players join or leave it, or it
+	starts or finishes a round. Nothing is sent when a watch starts, so the games are
+	listed first and then kept up to date from the watch.
+
+	Listing and watching take the same filters. A game is pushed while it matches a
+	watch's filters. When a game that matched stops matching, say it fills up or starts
+	a round on a watch for open seats or games between rounds, it's pushed once marked
+	unmatched so it can be taken off the list, and isn't pushed again until it matches.
+*/
+
+const (
+	defaultLobbyPageSize = 25
+	maxLobbyPageSize     = 100
+	// updates buffered for each watcher, a watcher that falls further behind misses updates
+	// but gets the game's latest state with its next one
+	lobbyWatchBuffer = 64
+)
+
+// lobbyWatchers fans game updates out to each WatchLobby stream
+type lobbyWatchers struct {
+	sync.Mutex
+	next     int
+	watchers map[int]chan *pb.LobbyGame
+}
+
+func newLobbyWatchers() *lobbyWatchers {
+	return &lobbyWatchers{watchers: map[int]chan *pb.LobbyGame{}}
+}
+
+func (l *lobbyWatchers) watch() (int, chan *pb.LobbyGame) {
+	l.Lock()
+	defer l.Unlock()
+	l.next++
+	c := make(chan *pb.LobbyGame, lobbyWatchBuffer)
+	l.watchers[l.next] = c
+	return l.next, c
+}
+
+func (l *lobbyWatchers) stop(id int) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.watchers, id)
+}
+
+func (l *lobbyWatchers) publish(g *pb.LobbyGame) {
+	l.Lock()
+	defer l.Unlock()
+	for _, c := range l.watchers {
+		select {
+		case c <- g:
+		default:
+		}
+	}
+}
+
+// lobbyFilter is the filters ListGamesRequest and WatchLobbyRequest share, unset filters match every game
+type lobbyFilter interface {
+	GetByVariant() bool
+	GetVariant() pb.GameVariant
+	GetBigBlind() int64
+	GetSeatsAvailable() int64
+	GetByInRound() bool
+	GetInRound() bool
+}
+
+// lobbyQuery filters the games with a lobby filter
+func (s *Server) lobbyQuery(f lobbyFilter) *gorm.DB {
+	q := s.gormDb.Model(&models.Game{})
+	if f.GetByVariant() {
+		q = q.Where("variant = ?", f.GetVariant().String())
+	}
+	if f.GetByInRound() {
+		q = q.Where("in_round = ?", f.GetInRound())
+	}
+	if f.GetBigBlind() != 0 {
+		// the blinds are derived from the game's min when they aren't set, see gameBlinds
+		q = q.Where("big_blind = ? OR (big_blind = 0 AND min * 2 = ?)", f.GetBigBlind(), f.GetBigBlind())
+	}
+	if f.GetSeatsAvailable() > 0 {
+		q = q.Where("(CASE WHEN max_seats = 0 THEN ? ELSE max_seats END) - "+
+			"(SELECT COUNT(*) FROM game_players WHERE game_players.game = games.id AND game_players.deleted_at IS NULL) >= ?",
+			game_ring.DefaultSeats, f.GetSeatsAvailable())
+	}
+	return q
+}
+
+// lobbyMatches reports whether a game matches a lobby filter, the same as lobbyQuery
+func lobbyMatches(g *pb.LobbyGame, f lobbyFilter) bool {
+	if f.GetByVariant() && g.GetVariant() != f.GetVariant() {
+		return false
+	}
+	if f.GetByInRound() && g.GetInRound() != f.GetInRound() {
+		return false
+	}
+	if f.GetBigBlind() != 0 && g.GetBigBlind() != f.GetBigBlind() {
+		return false
+	}
+	return g.GetSeats()-g.GetPlayers() >= f.GetSeatsAvailable()
+}
+
+// ListGames gets a page of the games matching the request's filters, in the order they were created
+func (s *Server) ListGames(ctx context.Context, in *pb.ListGamesRequest) (*pb.GameList, error) {
+	size := int(in.GetPageSize())
+	if size <= 0 {
+		size = defaultLobbyPageSize
+	} else if size > maxLobbyPageSize {
+		size = maxLobbyPageSize
+	}
+
+	out := &pb.GameList{}
+	if err := s.lobbyQuery(in).Count(&out.Total).Error; err != nil {
+		return nil, err
+	}
+	var games []*models.Game
+	if err := s.lobbyQuery(in).Order("id").Offset(int(in.GetPage()) * size).Limit(size).Find(&games).Error; err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, g := range games {
+		ids = append(ids, int64(g.ID))
+	}
+	players, err := s.gamePlayerCounts(ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range games {
+		out.Games = append(out.Games, lobbyGame(g.ProtoMarshal(), players[int64(g.ID)]))
+	}
+	return out, nil
+}
+
+// WatchLobby streams games matching the request's filters as their players or status change,
+// and games that stop matching them, until the stream is closed. Headers are sent once the watch
+// has started, so a client can wait on them to be sure it sees every change after
+func (s *Server) WatchLobby(in *pb.WatchLobbyRequest, stream pb.Poker_WatchLobbyServer) error {
+	id, updates := s.lobby.watch()
+	defer s.lobby.stop(id)
+
+	// the games matching when the watch started are the ones a client would have listed
+	var ids []int64
+	if err := s.lobbyQuery(in).Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+	matched := map[int64]bool{}
+	for _, game := range ids {
+		matched[game] = true
+	}
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case g := <-updates:
+			if lobbyMatches(g, in) {
+				matched[g.GetId()] = true
+			} else if matched[g.GetId()] {
+				delete(matched, g.GetId())
+				// updates are shared between the watchers
+				g = proto.Clone(g).(*pb.LobbyGame)
+				g.Unmatched = true
+			} else {
+				continue
+			}
+			if err := stream.Send(g); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// publishGame pushes a game's lobby state to the lobby's watchers
+func (s *Server) publishGame(ctx context.Context, id int64) error {
+	g, err := s.GetGame(ctx, &pb.Game{Id: id})
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return ErrGameDoesntExist
+	}
+	players, err := s.gamePlayerCounts(id)
+	if err != nil {
+		return err
+	}
+	s.lobby.publish(lobbyGame(g, players[id]))
+	return nil
+}
+
+// gamePlayerCounts returns the number of players in each of the games
+func (s *Server) gamePlayerCounts(games ...int64) (map[int64]int64, error) {
+	out := map[int64]int64{}
+	if len(games) == 0 {
+		return out, nil
+	}
+	rows, err := s.gormDb.Model(&models.GamePlayers{}).Where("game IN (?)", games).Select("game, COUNT(*)").Group("game").Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var game, count int64
+		if err := rows.Scan(&game, &count); err != nil {
+			return nil, err
+		}
+		out[game] = count
+	}
+	return out, rows.Err()
+}
+
+func lobbyGame(g *pb.Game, players int64) *pb.LobbyGame {
+	small, big := gameBlinds(g)
+	return &pb.LobbyGame{
+		Id:         g.GetId(),
+		Name:       g.GetName(),
+		Variant:    g.GetVariant(),
+		Limit:      betLimit(g),
+		SmallBlind: small,
+		BigBlind:   big,
+		Players:    players,
+		Seats:      game_ring.Seats(g),
+		InRound:    g.GetInRound(),
+	}
+}
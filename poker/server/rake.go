@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+const rakeDayFormat = "2006-01-02"
+
+// SetRake sets the rake configuration for a game
+func (s *Server) SetRake(ctx context.Context, g *pb.Game) (*pb.Game, error) {
+	if g.GetName() == "" {
+		return nil, ErrEmptyGameName
+	}
+
+	game, err := s.GetGame(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		return nil, ErrGameDoesntExist
+	}
+
+	if game.GetInRound() {
+		return nil, ErrGameInRound
+	}
+
+	if g.GetRakePercent() < 0 || g.GetRakePercent() > 100 || g.GetRakeCap() < 0 {
+		return nil, ErrInvalidRake
+	}
+
+	// use a map so zero values are persisted as well
+	toUpdate := map[string]interface{}{
+		"rake_percent":    g.GetRakePercent(),
+		"rake_cap":        g.GetRakeCap(),
+		"no_flop_no_drop": g.GetNoFlopNoDrop(),
+	}
+	if err := s.gormDb.Model(&models.Game{}).Where("id = ?", game.GetId()).Updates(toUpdate).Error; err != nil {
+		return nil, err
+	}
+
+	out, err := s.GetGame(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetRakeReport totals the rake ledger per game and per day. Only raked hands are in the ledger.
+// If a game is set on the request only that game is reported on.
+func (s *Server) GetRakeReport(ctx context.Context, in *pb.RakeReport) (*pb.RakeReport, error) {
+	var rakes []*models.Rake
+
+	q := s.gormDb
+	if in.GetGame() != 0 {
+		q = q.Where("game = ?", in.GetGame())
+	}
+	if err := q.Find(&rakes).Error; err != nil {
+		return nil, err
+	}
+
+	games := map[int64]*pb.RakeTotal{}
+	days := map[string]*pb.RakeTotal{}
+	out := &pb.RakeReport{Game: in.GetGame()}
+
+	for _, r := range rakes {
+		if _, ok := games[r.Game]; !ok {
+			games[r.Game] = &pb.RakeTotal{Game: r.Game}
+		}
+		games[r.Game].Chips += r.Chips
+		games[r.Game].Hands += 1
+
+		day := r.CreatedAt.UTC().Format(rakeDayFormat)
+		if _, ok := days[day]; !ok {
+			days[day] = &pb.RakeTotal{Day: day, Game: in.GetGame()}
+		}
+		days[day].Chips += r.Chips
+		days[day].Hands += 1
+
+		out.Chips += r.Chips
+	}
+
+	for _, v := range games {
+		out.Games = append(out.Games, v)
+	}
+	for _, v := range days {
+		out.Days = append(out.Days, v)
+	}
+	sort.Slice(out.Games, func(i, j int) bool {
+		return out.Games[i].GetGame() < out.Games[j].GetGame()
+	})
+	sort.Slice(out.Days, func(i, j int) bool {
+		return out.Days[i].GetDay() < out.Days[j].GetDay()
+	})
+
+	return out, nil
+}
+
+// calculateRake returns the chips the house takes from a pot.
+// No rake is taken when the hand ended before the flop if the game is no flop no drop.
+// Stud games treat fourth street as the flop.
+func calculateRake(g *pb.Game, r *pb.Round, pot int64) int64 {
+	if g.GetRakePercent() <= 0 || pot <= 0 {
+		return 0
+	}
+	if g.GetNoFlopNoDrop() && !sawFlop(r) {
+		return 0
+	}
+
+	rake := int64(float64(pot) * g.GetRakePercent() / 100)
+	if g.GetRakeCap() > 0 && rake > g.GetRakeCap() {
+		rake = g.GetRakeCap()
+	}
+	return rake
+}
+
+func sawFlop(r *pb.Round) bool {
+	if !isStud(r.GetVariant()) {
+		return r.GetFlop() != ""
+	}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetInHand() && len(p.GetUpCards()) > 2 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,343 @@
+package resim
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "grpc_texas_holdem/poker/protobufs"
+	"grpc_texas_holdem/poker/server"
+)
+
+/*
+	Deterministic re-simulation of recorded hands.
+
+	A recorded hand is its round, its game and the round's event log. The hand is set up
+	again on a simulator's own server with the game's settings, stacks, seats and button
+	from the log, since the game may have changed since, and dealt
+	from the round's revealed seeds so the deck comes out in the same order. Each recorded
+	action is then made through MakeBet, which validates it and moves the hand on with
+	SetNextRound just as it did when the hand was played.
+
+	Every event the simulated hand logs is checked against the recording, and any
+	difference is reported as a divergence.
+*/
+
+var (
+	ErrNoEvents = fmt.Errorf("hand has no events to simulate")
+	ErrNoSeeds  = fmt.Errorf("hand's server seed hasn't been revealed")
+)
+
+// Hand is a recorded round
+type Hand struct {
+	Round  *pb.Round
+	Game   *pb.Game
+	Events []*pb.HandEvent
+}
+
+// Load gets a finished round's hand from a server
+func Load(ctx context.Context, c pb.PokerClient, round int64) (*Hand, error) {
+	stream, err := c.ReplayHand(ctx, &pb.ReplayRequest{Round: round})
+	if err != nil {
+		return nil, err
+	}
+	events := []*pb.HandEvent{}
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	r, err := c.GetRound(ctx, &pb.Round{Id: round})
+	if err != nil {
+		return nil, err
+	}
+	g, err := c.GetGame(ctx, &pb.Game{Id: r.GetGame()})
+	if err != nil {
+		return nil, err
+	}
+	return &Hand{Round: r, Game: g, Events: events}, nil
+}
+
+// State is the engine's state at a step of a simulated hand
+type State struct {
+	Status pb.RoundStatus
+	Board  string
+	// chips bet in the round
+	Pot int64
+	// slot of the player on action
+	Action int64
+	// chips each player has left, by their recorded id
+	Stacks map[int64]int64
+}
+
+// Divergence is a point where the simulated hand didn't follow the recording.
+// Events are given with the recorded game, round and player ids
+type Divergence struct {
+	// nil when the simulation logged an event the recording doesn't have
+	Expected *pb.HandEvent
+	// nil when the simulation didn't log the recorded event
+	Got *pb.HandEvent
+	// set when the engine rejected a recorded action
+	Err error
+}
+
+func (d Divergence) String() string {
+	switch {
+	case d.Err != nil:
+		return fmt.Sprintf("event %d rejected: %v", d.Expected.GetSeq(), d.Err)
+	case d.Expected == nil:
+		return fmt.Sprintf("event %d not recorded: %v", d.Got.GetSeq(), d.Got)
+	case d.Got == nil:
+		return fmt.Sprintf("event %d not simulated: %v", d.Expected.GetSeq(), d.Expected)
+	}
+	return fmt.Sprintf("event %d expected %v, got %v", d.Expected.GetSeq(), d.Expected, d.Got)
+}
+
+// Result of a simulated hand
+type Result struct {
+	// state once the round started, then after each recorded action
+	Steps       []State
+	Divergences []Divergence
+}
+
+// Diverged returns true if the simulated hand didn't follow the recording
+func (r *Result) Diverged() bool {
+	return len(r.Divergences) > 0
+}
+
+// Simulator re-simulates hands on a server of its own
+type Simulator struct {
+	server *server.Server
+	// server seed of the hand being simulated
+	seed string
+}
+
+// New creates a simulator, its server uses a database of the name in the working directory
+func New(name string) (*Simulator, error) {
+	sim := &Simulator{}
+	s, err := server.NewServer(name, server.WithServerSeeds(func() string {
+		return sim.seed
+	}))
+	if err != nil {
+		return nil, err
+	}
+	sim.server = s
+	return sim, nil
+}
+
+// Simulate plays the hand again from its recorded actions
+func (sim *Simulator) Simulate(ctx context.Context, h *Hand) (*Result, error) {
+	if len(h.Events) == 0 || h.Events[0].GetType() != pb.HandEventType_START {
+		return nil, ErrNoEvents
+	}
+	if h.Round.GetServerSeed() == "" {
+		return nil, ErrNoSeeds
+	}
+	round, players, err := sim.start(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+
+	// simulated player ids back to the recorded ids
+	recorded := map[int64]int64{}
+	for id, simulated := range players {
+		recorded[simulated] = id
+	}
+	res := &Result{}
+	checked := 0
+	// check compares the events logged since the last check and records the state
+	check := func() error {
+		events, err := sim.server.HandEvents(ctx, round.GetId())
+		if err != nil {
+			return err
+		}
+		for i := checked; i < len(events); i++ {
+			got := recordedEvent(events[i], h.Round, recorded)
+			if i >= len(h.Events) {
+				res.Divergences = append(res.Divergences, Divergence{Got: got})
+			} else if !sameEvent(h.Events[i], got) {
+				res.Divergences = append(res.Divergences, Divergence{Expected: h.Events[i], Got: got})
+			}
+		}
+		checked = len(events)
+
+		state, err := sim.state(ctx, round, recorded)
+		if err != nil {
+			return err
+		}
+		res.Steps = append(res.Steps, state)
+		return nil
+	}
+	if err := check(); err != nil {
+		return nil, err
+	}
+
+	for _, e := range h.Events {
+		var err error
+		switch e.GetType() {
+		case pb.HandEventType_BET:
+			// the blinds and bring in are posted by the engine when the round starts
+			if e.GetBet() == pb.Bet_SMALL || e.GetBet() == pb.Bet_BIG || e.GetBet() == pb.Bet_BRING_IN {
+				continue
+			}
+			_, err = sim.server.MakeBet(ctx, &pb.Bet{
+				Player: players[e.GetPlayer()],
+				Game:   round.GetGame(),
+				Round:  round.GetId(),
+				Chips:  e.GetChips(),
+				Type:   e.GetBet(),
+				Status: e.GetStatus(),
+			})
+		case pb.HandEventType_RUNS:
+			_, err = sim.server.AgreeToRuns(ctx, &pb.RunAgreement{
+				Round:  round.GetId(),
+				Player: players[e.GetPlayer()],
+				Runs:   e.GetRuns(),
+			})
+		default:
+			continue
+		}
+		if err != nil {
+			res.Divergences = append(res.Divergences, Divergence{Expected: e, Err: err})
+			return res, nil
+		}
+		if err := check(); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := checked; i < len(h.Events); i++ {
+		res.Divergences = append(res.Divergences, Divergence{Expected: h.Events[i]})
+	}
+	return res, nil
+}
+
+// start sets up the hand's players and game and starts the round,
+// returning the round and the simulated id of each recorded player
+func (sim *Simulator) start(ctx context.Context, h *Hand) (*pb.Round, map[int64]int64, error) {
+	// names are unique to each simulated hand since players and games are never reused
+	name := fmt.Sprintf("resim_%d_%d", h.Round.GetId(), time.Now().UnixNano())
+
+	seats := []*pb.HandEvent{}
+	for _, e := range h.Events {
+		if e.GetType() == pb.HandEventType_SEAT {
+			seats = append(seats, e)
+		}
+	}
+	// the engine seats players and deals to them in id order, so they're created in the same order
+	sort.Slice(seats, func(i, j int) bool {
+		return seats[i].GetPlayer() < seats[j].GetPlayer()
+	})
+	players := map[int64]int64{}
+	created := &pb.Players{}
+	for _, e := range seats {
+		p, err := sim.server.CreatePlayer(ctx, &pb.Player{
+			Name:  fmt.Sprintf("%s_%d", name, e.GetPlayer()),
+			Chips: e.GetChips(),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		players[e.GetPlayer()] = p.GetId()
+		// ask for the recorded seat
+		p.Slot = e.GetSlot()
+		created.Players = append(created.Players, p)
+	}
+
+	// hands logged before their settings were only have the game as it is now
+	settings := h.Events[0].GetSettings()
+	if settings == nil {
+		settings = h.Game
+	}
+	g := proto.Clone(settings).(*pb.Game)
+	g.Id = 0
+	g.Name = name
+	g.Dealer = h.Events[0].GetSlot()
+	g.InRound = false
+	g.Players = nil
+	g.Rounds = nil
+	game, err := sim.server.CreateGame(ctx, g)
+	if err != nil {
+		return nil, nil, err
+	}
+	game.Players = created
+	if _, err := sim.server.SetGamePlayers(ctx, game); err != nil {
+		return nil, nil, err
+	}
+	game, err = sim.server.GetGame(ctx, game)
+	if err != nil {
+		return nil, nil, err
+	}
+	game, err = sim.server.AllocateGameSlots(ctx, game)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the server seed is committed to when the round is created
+	sim.seed = h.Round.GetServerSeed()
+	round, err := sim.server.CreateRoundFromGame(ctx, game)
+	if err != nil {
+		return nil, nil, err
+	}
+	round, err = sim.server.ValidatePreRound(ctx, round)
+	if err != nil {
+		return nil, nil, err
+	}
+	round.ClientSeed = h.Round.GetClientSeed()
+	round, err = sim.server.StartRound(ctx, round)
+	if err != nil {
+		return nil, nil, err
+	}
+	return round, players, nil
+}
+
+func (sim *Simulator) state(ctx context.Context, round *pb.Round, recorded map[int64]int64) (State, error) {
+	r, err := sim.server.GetRound(ctx, round)
+	if err != nil {
+		return State{}, err
+	}
+	bets, err := sim.server.GetRoundBets(ctx, r)
+	if err != nil {
+		return State{}, err
+	}
+	out := State{
+		Status: r.GetStatus(),
+		Board:  r.GetFlop() + r.GetRiver() + r.GetTurn(),
+		Action: r.GetAction(),
+		Stacks: map[int64]int64{},
+	}
+	for _, b := range bets.GetBets() {
+		out.Pot += b.GetChips()
+	}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		out.Stacks[recorded[p.GetId()]] = p.GetChips()
+	}
+	return out, nil
+}
+
+// recordedEvent copies a simulated event with the recorded hand's ids
+func recordedEvent(e *pb.HandEvent, r *pb.Round, recorded map[int64]int64) *pb.HandEvent {
+	out := proto.Clone(e).(*pb.HandEvent)
+	out.Game = r.GetGame()
+	out.Round = r.GetId()
+	if e.GetPlayer() != 0 {
+		out.Player = recorded[e.GetPlayer()]
+	}
+	return out
+}
+
+// sameEvent compares everything but the ids and when the events happened
+func sameEvent(a, b *pb.HandEvent) bool {
+	a, b = proto.Clone(a).(*pb.HandEvent), proto.Clone(b).(*pb.HandEvent)
+	a.Id, b.Id = 0, 0
+	a.Time, b.Time = 0, 0
+	return proto.Equal(a, b)
+}
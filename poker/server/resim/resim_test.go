@@ -0,0 +1,276 @@
+package resim_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	pb "grpc_texas_holdem/poker/protobufs"
+	"grpc_texas_holdem/poker/server"
+	"grpc_texas_holdem/poker/server/resim"
+	"log"
+	"math/rand"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+var (
+	testClient   pb.PokerClient
+	testDatabase string
+	simDatabase  string
+	ops          int
+)
+
+func getUniqueName() string {
+	ops++
+	return fmt.Sprintf("resimName_%d", ops)
+}
+
+// hands are recorded on a server of their own and re-simulated on the simulator's
+func TestMain(m *testing.M) {
+	rand.Seed(time.Now().Unix())
+	testDatabase = fmt.Sprintf("test_resim_%d", rand.Int63())
+	simDatabase = fmt.Sprintf("test_resim_sim_%d", rand.Int63())
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	serv, err := server.NewServer(testDatabase)
+	if err != nil {
+		log.Fatalf("failed to Start poker server: %v", err)
+	}
+	pb.RegisterPokerServer(s, serv)
+	go s.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+	testClient = pb.NewPokerClient(conn)
+
+	code := m.Run()
+	conn.Close()
+	s.Stop()
+	for _, name := range []string{testDatabase, simDatabase} {
+		os.Remove(fmt.Sprintf("./%s.db", name))
+		os.Remove(fmt.Sprintf("./%s.db-journal", name))
+	}
+	os.Exit(code)
+}
+
+// playHand starts a round between players with the stacks and makes each bet in turn,
+// checking or calling once the bets run out
+func playHand(t *testing.T, ctx context.Context, stacks []int64, runs []int32, bets []*pb.Bet) *pb.Round {
+	players := &pb.Players{}
+	for _, chips := range stacks {
+		players.Players = append(players.Players, &pb.Player{Name: getUniqueName(), Chips: chips})
+	}
+	_, err := testClient.CreatePlayers(ctx, players)
+	require.NoError(t, err)
+	game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), SmallBlind: 10, BigBlind: 20})
+	require.NoError(t, err)
+	game.Players = players
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.GetGame(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.AllocateGameSlots(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.SetButtonPositions(ctx, game)
+	require.NoError(t, err)
+
+	round, err := testClient.CreateRoundFromGame(ctx, game)
+	require.NoError(t, err)
+	round, err = testClient.ValidatePreRound(ctx, round)
+	require.NoError(t, err)
+	round.ClientSeed = getUniqueName()
+	round, err = testClient.StartRound(ctx, round)
+	require.NoError(t, err)
+
+	for i, n := range runs {
+		_, err := testClient.AgreeToRuns(ctx, &pb.RunAgreement{
+			Round:  round.GetId(),
+			Player: round.GetPlayers().GetPlayers()[i].GetId(),
+			Runs:   n,
+		})
+		require.NoError(t, err)
+	}
+
+	for {
+		round, err = testClient.GetRound(ctx, round)
+		require.NoError(t, err)
+		if round.GetStatus() == pb.RoundStatus_OVER {
+			return round
+		}
+		p, err := testClient.GetPlayerOnBet(ctx, round)
+		require.NoError(t, err)
+		bet := &pb.Bet{Type: pb.Bet_CALL}
+		if len(bets) > 0 {
+			bet, bets = bets[0], bets[1:]
+		}
+		if bet.GetType() == pb.Bet_CALL {
+			amt, err := testClient.GetAmountToCallForPlayer(ctx, &pb.AmountToCall{Player: p, Round: round})
+			require.NoError(t, err)
+			bet.Chips = amt.GetChips()
+		}
+		bet.Player, bet.Game, bet.Round, bet.Status = p.GetId(), round.GetGame(), round.GetId(), round.GetStatus()
+		_, err = testClient.MakeBet(ctx, bet)
+		require.NoError(t, err)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	sim, err := resim.New(simDatabase)
+	require.NoError(t, err)
+
+	tests := []struct {
+		Name   string
+		Stacks []int64
+		Runs   []int32
+		Bets   []*pb.Bet
+	}{
+		{
+			Name:   "Checked down",
+			Stacks: []int64{1000, 1000, 1000},
+		},
+		{
+			Name:   "Folded and raised",
+			Stacks: []int64{1000, 1000, 1000},
+			Bets: []*pb.Bet{
+				{Type: pb.Bet_FOLD},
+				{Type: pb.Bet_RAISE, Chips: 50},
+			},
+		},
+		{
+			Name:   "All in run twice",
+			Stacks: []int64{20, 20},
+			Runs:   []int32{2, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			round := playHand(t, ctx, tt.Stacks, tt.Runs, tt.Bets)
+			hand, err := resim.Load(ctx, testClient, round.GetId())
+			require.NoError(t, err)
+
+			res, err := sim.Simulate(ctx, hand)
+			require.NoError(t, err)
+			require.False(t, res.Diverged(), "%v", res.Divergences)
+
+			final := res.Steps[len(res.Steps)-1]
+			require.Equal(t, pb.RoundStatus_OVER, final.Status)
+			require.Equal(t, round.GetFlop()+round.GetRiver()+round.GetTurn(), final.Board)
+			for _, p := range round.GetPlayers().GetPlayers() {
+				require.Equal(t, p.GetChips(), final.Stacks[p.GetId()])
+			}
+
+			// the same hand simulates the same way every time
+			again, err := sim.Simulate(ctx, hand)
+			require.NoError(t, err)
+			require.Equal(t, res.Steps, again.Steps)
+		})
+	}
+}
+
+func TestSimulate_GameChanged(t *testing.T) {
+	ctx := context.Background()
+	sim, err := resim.New(simDatabase)
+	require.NoError(t, err)
+
+	round := playHand(t, ctx, []int64{1000, 1000, 1000}, nil, []*pb.Bet{{Type: pb.Bet_RAISE, Chips: 60}})
+
+	// the blinds and rake change before the next hand
+	game, err := testClient.GetGame(ctx, &pb.Game{Id: round.GetGame()})
+	require.NoError(t, err)
+	_, err = testClient.SetBlinds(ctx, &pb.Game{Id: game.GetId(), Name: game.GetName(), SmallBlind: 50, BigBlind: 100, MinBet: 100})
+	require.NoError(t, err)
+	_, err = testClient.SetRake(ctx, &pb.Game{Id: game.GetId(), Name: game.GetName(), RakePercent: 10})
+	require.NoError(t, err)
+
+	hand, err := resim.Load(ctx, testClient, round.GetId())
+	require.NoError(t, err)
+	require.Equal(t, int64(100), hand.Game.GetBigBlind())
+	settings := hand.Events[0].GetSettings()
+	require.Equal(t, int64(10), settings.GetSmallBlind())
+	require.Equal(t, int64(20), settings.GetBigBlind())
+	require.Equal(t, float64(0), settings.GetRakePercent())
+
+	// the hand is played with the settings it was logged with
+	res, err := sim.Simulate(ctx, hand)
+	require.NoError(t, err)
+	require.False(t, res.Diverged(), "%v", res.Divergences)
+	final := res.Steps[len(res.Steps)-1]
+	for _, p := range round.GetPlayers().GetPlayers() {
+		require.Equal(t, p.GetChips(), final.Stacks[p.GetId()])
+	}
+}
+
+func TestSimulate_Divergence(t *testing.T) {
+	ctx := context.Background()
+	sim, err := resim.New(simDatabase)
+	require.NoError(t, err)
+
+	round := playHand(t, ctx, []int64{1000, 1000, 1000}, nil, nil)
+	load := func() *resim.Hand {
+		hand, err := resim.Load(ctx, testClient, round.GetId())
+		require.NoError(t, err)
+		return hand
+	}
+
+	t.Run("Rejected bet", func(t *testing.T) {
+		hand := load()
+		for _, e := range hand.Events {
+			if e.GetType() == pb.HandEventType_BET && e.GetBet() == pb.Bet_CALL {
+				e.Chips++
+				break
+			}
+		}
+		res, err := sim.Simulate(ctx, hand)
+		require.NoError(t, err)
+		require.True(t, res.Diverged())
+		last := res.Divergences[len(res.Divergences)-1]
+		require.Equal(t, server.ErrIncorrectBetForBetType, last.Err)
+	})
+
+	t.Run("Different cards", func(t *testing.T) {
+		hand := load()
+		var changed *pb.HandEvent
+		for _, e := range hand.Events {
+			if e.GetType() == pb.HandEventType_DEAL && e.GetPlayer() == 0 {
+				e.Cards = "2c2d2h"
+				changed = e
+				break
+			}
+		}
+		res, err := sim.Simulate(ctx, hand)
+		require.NoError(t, err)
+		require.Len(t, res.Divergences, 1)
+		require.Equal(t, changed, res.Divergences[0].Expected)
+		require.Equal(t, round.GetFlop(), res.Divergences[0].Got.GetCards())
+		require.Nil(t, res.Divergences[0].Err)
+	})
+
+	t.Run("Missing events", func(t *testing.T) {
+		hand := load()
+		settled := hand.Events[len(hand.Events)-1]
+		hand.Events = hand.Events[:len(hand.Events)-1]
+		res, err := sim.Simulate(ctx, hand)
+		require.NoError(t, err)
+		require.Len(t, res.Divergences, 1)
+		require.Nil(t, res.Divergences[0].Expected)
+		require.Equal(t, settled.GetChips(), res.Divergences[0].Got.GetChips())
+	})
+
+	t.Run("No seeds", func(t *testing.T) {
+		hand := load()
+		hand.Round.ServerSeed = ""
+		_, err := sim.Simulate(ctx, hand)
+		require.Equal(t, resim.ErrNoSeeds, err)
+	})
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+	"grpc_texas_holdem/poker/server/game_ring"
+)
+
+/*
+	Seats and waitlists.
+
+	A player's seat is their slot. Players can ask for a seat when they join a game, and
+	keep it from hand to hand; only players without a seat are given one when the slots
+	are allocated, taking the lowest open seats.
+
+	A slot is the same in every game a player is in, so a seat can only be taken if it's
+	open in all of them, and a player already in a game keeps their seat when they join
+	another without asking for one.
+
+	Players joining a full game are put on its waitlist, and the longest waiting player
+	who can be seated is seated whenever a player leaves, in their seat if they have one
+	at another game, otherwise the lowest seat open in all their games.
+*/
+
+// lowestOpenSeat returns the lowest of the seats none of the players are in, 0 if every seat is taken
+func lowestOpenSeat(players []*pb.Player, seats int64) int64 {
+	taken := map[int64]bool{}
+	for _, p := range players {
+		taken[p.GetSlot()] = true
+	}
+	for seat := int64(1); seat <= seats; seat++ {
+		if !taken[seat] {
+			return seat
+		}
+	}
+	return 0
+}
+
+// otherSeats are the seats of a player's other games, which the player's seat has to fit in
+type otherSeats struct {
+	// other games the player is in
+	games int
+	// seats at the smallest of them
+	seats int64
+	// seats taken by other players in them
+	taken map[int64]bool
+}
+
+// open reports whether the seat is free at each of the player's other games
+func (o *otherSeats) open(seat int64) bool {
+	return seat <= o.seats && !o.taken[seat]
+}
+
+// otherSeats gets the seats of the games a player is in other than the game
+func (s *Server) otherSeats(ctx context.Context, player int64, game int64) (*otherSeats, error) {
+	var games []*models.GamePlayers
+	if err := s.gormDb.Where("player = ? AND game != ?", player, game).Find(&games).Error; err != nil {
+		return nil, err
+	}
+	out := &otherSeats{games: len(games), seats: game_ring.MaxSeats, taken: map[int64]bool{}}
+	for _, gp := range games {
+		g, err := s.GetGame(ctx, &pb.Game{Id: gp.Game})
+		if err != nil {
+			return nil, err
+		}
+		if game_ring.Seats(g) < out.seats {
+			out.seats = game_ring.Seats(g)
+		}
+		for _, p := range g.GetPlayers().GetPlayers() {
+			if p.GetId() != player && p.GetSlot() != 0 {
+				out.taken[p.GetSlot()] = true
+			}
+		}
+	}
+	return out, nil
+}
+
+// seatPlayer adds a player to a game in a seat, 0 leaves them without one until the slots are allocated.
+// The seat is expected to be open at the player's other games, see otherSeats
+func (s *Server) seatPlayer(ctx context.Context, game int64, player int64, seat int64) error {
+	if err := s.gormDb.Create(&models.GamePlayers{Player: player, Game: game}).Error; err != nil {
+		return err
+	}
+	// a player's slot is left over from the last game they played, so it's always set
+	return s.gormDb.Model(&models.Player{}).Where("id = ?", player).Update("slot", seat).Error
+}
+
+// addToWaitlist puts a player at the end of a game's waitlist, if they're not already on it
+func (s *Server) addToWaitlist(ctx context.Context, game int64, player int64) error {
+	w := &models.Waitlist{Game: game, Player: player}
+	return s.gormDb.Where(w).FirstOrCreate(w).Error
+}
+
+// seatFromWaitlist fills a game's open seats with the players waiting the longest,
+// players that can't be seated without taking a seat at one of their other games keep waiting
+func (s *Server) seatFromWaitlist(ctx context.Context, game int64) error {
+	for {
+		g, err := s.GetGame(ctx, &pb.Game{Id: game})
+		if err != nil {
+			return err
+		}
+		if lowestOpenSeat(g.GetPlayers().GetPlayers(), game_ring.Seats(g)) == 0 ||
+			int64(len(g.GetPlayers().GetPlayers())) >= game_ring.Seats(g) {
+			return nil
+		}
+
+		var waiting []*models.Waitlist
+		if err := s.gormDb.Where("game = ?", game).Order("id").Find(&waiting).Error; err != nil {
+			return err
+		}
+		seated := false
+		for _, w := range waiting {
+			seat, err := s.waitlistSeat(ctx, g, w.Player)
+			if err != nil {
+				return err
+			}
+			if seat == 0 {
+				continue
+			}
+			if err := s.gormDb.Delete(w).Error; err != nil {
+				return err
+			}
+			if err := s.seatPlayer(ctx, game, w.Player, seat); err != nil {
+				return err
+			}
+			seated = true
+			break
+		}
+		if !seated {
+			return nil
+		}
+	}
+}
+
+// waitlistSeat returns the seat a waiting player can take at the game, 0 if there isn't one.
+// Players keep the seat they have at their other games, others take the lowest seat open in all their games
+func (s *Server) waitlistSeat(ctx context.Context, g *pb.Game, player int64) (int64, error) {
+	others, err := s.otherSeats(ctx, player, g.GetId())
+	if err != nil {
+		return 0, err
+	}
+	p, err := s.GetPlayer(ctx, &pb.Player{Id: player})
+	if err != nil {
+		return 0, err
+	}
+	taken := map[int64]bool{}
+	for _, seated := range g.GetPlayers().GetPlayers() {
+		taken[seated.GetSlot()] = true
+	}
+
+	if others.games > 0 && p.GetSlot() != 0 {
+		if p.GetSlot() <= game_ring.Seats(g) && !taken[p.GetSlot()] {
+			return p.GetSlot(), nil
+		}
+		return 0, nil
+	}
+	for seat := int64(1); seat <= game_ring.Seats(g); seat++ {
+		if !taken[seat] && others.open(seat) {
+			return seat, nil
+		}
+	}
+	return 0, nil
+}
+
+// GetWaitlist gets the players waiting for a seat at a game
+func (s *Server) GetWaitlist(ctx context.Context, g *pb.Game) (*pb.Waitlist, error) {
+	var waiting []*models.Waitlist
+	if err := s.gormDb.Where("game = ?", g.GetId()).Order("id").Find(&waiting).Error; err != nil {
+		return nil, err
+	}
+
+	ids := &pb.Players{}
+	for _, w := range waiting {
+		ids.Players = append(ids.Players, &pb.Player{Id: w.Player})
+	}
+	players, err := s.GetPlayers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byId := map[int64]*pb.Player{}
+	for _, p := range players.GetPlayers() {
+		byId[p.GetId()] = p
+	}
+
+	out := &pb.Waitlist{Game: g.GetId(), Players: &pb.Players{}}
+	for _, w := range waiting {
+		if p, ok := byId[w.Player]; ok {
+			out.Players.Players = append(out.Players.Players, p)
+		}
+	}
+	return out, nil
+}
+
+// LeaveWaitlist takes the players off a game's waitlist, returning who's left waiting
+func (s *Server) LeaveWaitlist(ctx context.Context, w *pb.Waitlist) (*pb.Waitlist, error) {
+	for _, p := range w.GetPlayers().GetPlayers() {
+		if err := s.gormDb.Where("game = ? AND player = ?", w.GetGame(), p.GetId()).Delete(&models.Waitlist{}).Error; err != nil {
+			return nil, err
+		}
+	}
+	return s.GetWaitlist(ctx, &pb.Game{Id: w.GetGame()})
+}
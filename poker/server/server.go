@@ -29,8 +29,8 @@ var (
 	ErrInvalidPlayerCount      = fmt.Errorf("can not create game with supplied count of players")
 	ErrGameNameExists          = fmt.Errorf("game with that name already exists")
 	ErrEmptyGameName           = fmt.Errorf("can not create game with empty name")
-	ErrInvalidSlotNumber       = fmt.Errorf("slot value invalid must be between 1-8")
-	ErrInvalidSlotMinMax       = fmt.Errorf("slot value is greater than 8 or less than 1")
+	ErrInvalidSlotNumber       = fmt.Errorf("slot value invalid must be between 1 and the game's max seats")
+	ErrInvalidSlotMinMax       = fmt.Errorf("slot value is greater than the game's max seats or less than 1")
 	ErrGameDoesntExist         = fmt.Errorf("no game found")
 	ErrInvalidButtonAllocation = fmt.Errorf("buttons are not allocated correctly")
 	ErrNoBetSet                = fmt.Errorf("no bet set for game")
@@ -52,17 +52,63 @@ var (
 	ErrWrongBetStatus          = fmt.Errorf("wrong bet status")
 	ErrNoExistingCards         = fmt.Errorf("expecting existing cards, but no cards for player in hand")
 	ErrNoWinningPlayer         = fmt.Errorf("no winning player determined")
+	ErrInvalidBlinds           = fmt.Errorf("big blind must be greater or equal to the small blind")
+	ErrOneSidedBlinds          = fmt.Errorf("small and big blind must both be set or both be unset")
+	ErrInvalidMinBet           = fmt.Errorf("minimum bet can not be negative")
+	ErrInsufficientRaise       = fmt.Errorf("raise is less than the game minimum bet or the last raise")
+	ErrInvalidRake             = fmt.Errorf("rake percent must be between 0-100 and cap can not be negative")
+	ErrExceedsPotLimit         = fmt.Errorf("raise is greater than the size of the pot")
+	ErrNotEnoughCards          = fmt.Errorf("not enough cards left in the deck")
+	ErrRoundNotOver            = fmt.Errorf("round is not over")
+	ErrRoundIsOver             = fmt.Errorf("round is over")
+	ErrInvalidRuns             = fmt.Errorf("runs must be between 1 and the maximum runs")
+	ErrTooManyIterations       = fmt.Errorf("iterations must be at most the maximum equity iterations")
+	ErrInvalidDateRange        = fmt.Errorf("date range must start before it ends")
+	ErrEmptySeasonName         = fmt.Errorf("can not create season with empty name")
+	ErrSeasonNameExists        = fmt.Errorf("season with that name already exists")
+	ErrSeasonDoesntExist       = fmt.Errorf("no season found")
+	ErrSeatTaken               = fmt.Errorf("seat is taken by another player")
+	ErrInvalidMaxSeats         = fmt.Errorf("max seats must be 2, 6, 8, 9 or 10")
+	ErrSpectatorSeated         = fmt.Errorf("players seated at a game can't spectate it")
+	ErrSpectating              = fmt.Errorf("players spectating a game can't act at it")
+	ErrNoServerSeed            = fmt.Errorf("round has no committed server seed")
 )
 
 // TODOS:
 // Add test for exiting early when everyone folds.
 
 type Server struct {
-	gormDb *gorm.DB
+	gormDb      *gorm.DB
+	shuffler    deck.Shuffler
+	serverSeeds func() string
+	lobby       *lobbyWatchers
+	spectators  *spectators
 }
 
-func NewServer(name string) (*Server, error) {
-	s := &Server{}
+// Option configures a Server when it is created
+type Option func(*Server)
+
+// WithShuffler sets the shuffler used to create each round's deck.
+// Defaults to a deck.CryptoShuffler
+func WithShuffler(shuffler deck.Shuffler) Option {
+	return func(s *Server) {
+		s.shuffler = shuffler
+	}
+}
+
+// WithServerSeeds sets where each round's server seed comes from in place of the shuffler,
+// so a recorded hand can be dealt again from its revealed seeds
+func WithServerSeeds(seeds func() string) Option {
+	return func(s *Server) {
+		s.serverSeeds = seeds
+	}
+}
+
+func NewServer(name string, opts ...Option) (*Server, error) {
+	s := &Server{shuffler: deck.CryptoShuffler{}, lobby: newLobbyWatchers(), spectators: newSpectators()}
+	for _, opt := range opts {
+		opt(s)
+	}
 	err := s.setupDatabase(name)
 	return s, err
 }
@@ -111,6 +157,34 @@ func (s *Server) setupDatabase(name string) error {
 		return err
 	}
 
+	if err := db.AutoMigrate(&models.Rake{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&models.StreetEquity{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&models.HandEvent{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&models.PlayerHand{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&models.Season{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&models.Standing{}).Error; err != nil {
+		return err
+	}
+
+	if err := db.AutoMigrate(&models.Waitlist{}).Error; err != nil {
+		return err
+	}
+
 	s.gormDb = db
 	return nil
 }
@@ -221,7 +295,23 @@ func (s *Server) GetGame(ctx context.Context, in *pb.Game) (*pb.Game, error) {
 	return game, nil
 }
 
+// GetRound gets a round with its players, and in training games the outs of each player in hand
 func (s *Server) GetRound(ctx context.Context, in *pb.Round) (*pb.Round, error) {
+	round, err := s.getRound(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if round.GetTraining() {
+		round.Outs, err = roundOuts(round)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return round, nil
+}
+
+// getRound gets a round with its players. The outs are only worked out for clients, see GetRound
+func (s *Server) getRound(ctx context.Context, in *pb.Round) (*pb.Round, error) {
 
 	r := &models.Round{}
 
@@ -246,6 +336,31 @@ func (s *Server) GetRound(ctx context.Context, in *pb.Round) (*pb.Round, error)
 	round := r.ProtoMarshal()
 	round.Players = players
 
+	// the hands shown down are saved when the pot is settled
+	if round.GetStatus() == pb.RoundStatus_OVER {
+		var shown []*models.RoundPlayers
+		if err := s.gormDb.Where("round = ? AND hand_name != ''", round.GetId()).Find(&shown).Error; err != nil {
+			return nil, err
+		}
+		for _, row := range shown {
+			for _, p := range round.GetPlayers().GetPlayers() {
+				if p.GetId() == row.Player {
+					p.HandCategory = pb.HandCategory(pb.HandCategory_value[row.HandCategory])
+					p.HandName = row.HandName
+					p.BestHand = row.BestHand
+				}
+			}
+		}
+	}
+
+	if round.GetAllIn() {
+		var equities []*models.StreetEquity
+		if err := s.gormDb.Where("round = ?", round.GetId()).Order("id").Find(&equities).Error; err != nil {
+			return nil, err
+		}
+		round.Equities = models.StreetEquitiesMarshal(equities)
+	}
+
 	return round, nil
 }
 
@@ -326,8 +441,18 @@ func (s *Server) GetGamePlayersByGameId(ctx context.Context, in *pb.Game) (*pb.P
 // This method is flexible so if there are existing players in the game
 // it will only add the difference (If the total number of players is less than 9 and greater than 1)
 // This is not an indepodent operation so existing players are considered and only the difference is added
+// Players can ask for a seat by setting their slot, players that don't are seated when the slots are allocated,
+// unless they're in another game, where they keep the seat they have. A seat has to be open in every game the player is in.
+// Players joining once the game is full are put on its waitlist, unless they asked for a seat, which is taken
+// Players spectating the game can't join it
 func (s *Server) SetGamePlayers(ctx context.Context, g *pb.Game) (*pb.Players, error) {
 
+	game, err := s.GetGame(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	seats := game_ring.Seats(game)
+
 	// 1. Get existing players IDs in the game
 	existingIds, err := s.GetGamePlayersByGameId(ctx, g)
 
@@ -352,21 +477,76 @@ func (s *Server) SetGamePlayers(ctx context.Context, g *pb.Game) (*pb.Players, e
 		return nil, err
 	}
 
-	// 3.a create a map of requesting playerIds to boolean of if they should join
-	playersToJoinMap := map[int64]*pb.Player{}
+	// 3.a the seats the requesting players asked for
+	requestedSeats := map[string]int64{}
+	for _, p := range g.GetPlayers().GetPlayers() {
+		requestedSeats[p.GetName()] = p.GetSlot()
+	}
+
+	// 3.b seat the requesting players not already in the game in the order they were created,
+	// until the game is full
+	takenSeats := map[int64]bool{}
+	for _, p := range existingPlayerRecords.GetPlayers() {
+		takenSeats[p.GetSlot()] = true
+	}
+	playersToJoin := []*pb.Player{}
+	playersToWait := []*pb.Player{}
 	for _, p := range playersToJoinRecords.GetPlayers() {
-		// Player is not already on the game list
-		if _, ok := existingPlayersMap[p.GetId()]; !ok {
-			playersToJoinMap[p.GetId()] = p
+		// Player is already on the game list
+		if _, ok := existingPlayersMap[p.GetId()]; ok {
+			continue
+		}
+		if s.spectators.spectating(game.GetId(), p.GetId()) {
+			return nil, ErrSpectating
+		}
+		full := int64(len(existingPlayersMap)+len(playersToJoin)) >= seats
+		others, err := s.otherSeats(ctx, p.GetId(), game.GetId())
+		if err != nil {
+			return nil, err
+		}
+		seat := requestedSeats[p.GetName()]
+		if seat == 0 && others.games > 0 {
+			// the player's seat is the same as at their other games, they wait for it when the game is full
+			if full {
+				playersToWait = append(playersToWait, p)
+				continue
+			}
+			seat = p.GetSlot()
+		}
+		if seat != 0 {
+			if seat < 1 || seat > seats || seat > others.seats {
+				return nil, ErrInvalidSlotMinMax
+			}
+			// every seat is taken once the game is full, so a player asking for one isn't waitlisted
+			if takenSeats[seat] || others.taken[seat] {
+				return nil, ErrSeatTaken
+			}
+		}
+		if full {
+			playersToWait = append(playersToWait, p)
+			continue
 		}
+		if seat != 0 {
+			takenSeats[seat] = true
+		}
+		p.Slot = seat
+		playersToJoin = append(playersToJoin, p)
 	}
 
-	for _, shouldAdd := range playersToJoinMap {
-		toCreate := &models.GamePlayers{Player: shouldAdd.GetId(), Game: g.GetId()}
-		if err := s.gormDb.Create(toCreate).Error; err != nil {
+	for _, p := range playersToJoin {
+		if err := s.seatPlayer(ctx, g.GetId(), p.GetId(), p.GetSlot()); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range playersToWait {
+		if err := s.addToWaitlist(ctx, g.GetId(), p.GetId()); err != nil {
+			return nil, err
+		}
+	}
+	if len(playersToJoin) > 0 {
+		if err := s.publishGame(ctx, g.GetId()); err != nil {
 			return nil, err
 		}
-
 	}
 	players, err := s.GetGamePlayersByGameId(ctx, g)
 	if err != nil {
@@ -375,11 +555,36 @@ func (s *Server) SetGamePlayers(ctx context.Context, g *pb.Game) (*pb.Players, e
 	return players, err
 }
 
+// SetPlayerSlot sits a player in a seat. A player's seat is the same in every game they're in, so the
+// seat can't be taken by another player in any of them. Players not in a game can take any seat of the largest table
+// Players can't move while they're spectating a game they're in
 func (s *Server) SetPlayerSlot(ctx context.Context, p *pb.Player) (*pb.Player, error) {
 
-	if p.GetSlot() > 8 || p.GetSlot() < 1 {
+	if p.GetSlot() > game_ring.MaxSeats || p.GetSlot() < 1 {
 		return nil, ErrInvalidSlotMinMax
 	}
+
+	var games []*models.GamePlayers
+	if err := s.gormDb.Where("player = ?", p.GetId()).Find(&games).Error; err != nil {
+		return nil, err
+	}
+	for _, gp := range games {
+		game, err := s.GetGame(ctx, &pb.Game{Id: gp.Game})
+		if err != nil {
+			return nil, err
+		}
+		if p.GetSlot() > game_ring.Seats(game) {
+			return nil, ErrInvalidSlotMinMax
+		}
+		if s.spectators.spectating(game.GetId(), p.GetId()) {
+			return nil, ErrSpectating
+		}
+		for _, other := range game.GetPlayers().GetPlayers() {
+			if other.GetId() != p.GetId() && other.GetSlot() == p.GetSlot() {
+				return nil, ErrSeatTaken
+			}
+		}
+	}
 	out := &models.Player{}
 
 	if err := s.gormDb.Where("id = ?", p.GetId()).Find(out).Update(
@@ -395,22 +600,42 @@ func (s *Server) SetPlayerSlot(ctx context.Context, p *pb.Player) (*pb.Player, e
 
 }
 
+// AllocateGameSlots gives the game's players without a seat the lowest open seats, seated players keep theirs
 func (s *Server) AllocateGameSlots(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 
+	game, err := s.GetGame(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		return nil, ErrGameDoesntExist
+	}
+
 	players := g.GetPlayers().GetPlayers()
-	if len(players) < 2 || len(players) > 8 {
+	// the table size is the game's, not whatever the caller sent
+	seats := game_ring.Seats(game)
+	if len(players) < 2 || int64(len(players)) > seats {
 		return nil, ErrInvalidPlayerCount
 	}
-	for i, p := range players {
 
-		// start at 1 because 0 is the nil value of a slot so 0 signifies unassigned
-		slot := i + 1
-		p.Slot = int64(slot)
+	// players keep their seats, 0 is the nil value of a slot so 0 signifies unassigned
+	seated := []*pb.Player{}
+	unseated := []*pb.Player{}
+	taken := map[int64]bool{}
+	for _, p := range players {
+		if p.GetSlot() < 1 || p.GetSlot() > seats || taken[p.GetSlot()] {
+			unseated = append(unseated, p)
+			continue
+		}
+		taken[p.GetSlot()] = true
+		seated = append(seated, p)
 	}
 
-	for _, p := range players {
-		_, err := s.SetPlayerSlot(ctx, p)
-		if err != nil {
+	// the rest take the lowest open seats in list order
+	for _, p := range unseated {
+		p.Slot = lowestOpenSeat(seated, seats)
+		seated = append(seated, p)
+		if _, err := s.SetPlayerSlot(ctx, p); err != nil {
 			return nil, err
 		}
 	}
@@ -428,6 +653,14 @@ func (s *Server) CreateGame(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 		return nil, ErrEmptyGameName
 	}
 
+	if g.GetMaxSeats() != 0 && !game_ring.TableSizes[g.GetMaxSeats()] {
+		return nil, ErrInvalidMaxSeats
+	}
+
+	if oneSidedBlinds(g) {
+		return nil, ErrOneSidedBlinds
+	}
+
 	exists, err := s.GetGameByName(ctx, g)
 	if err != nil {
 		return nil, err
@@ -446,6 +679,9 @@ func (s *Server) CreateGame(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := s.publishGame(ctx, game.GetId()); err != nil {
+		return nil, err
+	}
 	return game, nil
 
 }
@@ -463,12 +699,13 @@ func (s *Server) SetButtonPositions(ctx context.Context, g *pb.Game) (*pb.Game,
 		return nil, ErrGameDoesntExist
 	}
 
+	players := game.GetPlayers().GetPlayers()
 	toUpdate := models.Game{
-		// Randomly allocate a dealer
-		Dealer: int64(rand.Intn(len(game.GetPlayers().GetPlayers()))) + 1,
+		// Randomly allocate a dealer to one of the players' seats
+		Dealer: players[rand.Intn(len(players))].GetSlot(),
 	}
 
-	if err := s.gormDb.Where("id = ?", game.GetId()).Find(game).Updates(toUpdate).Error; err != nil {
+	if err := s.gormDb.Where("id = ?", game.GetId()).Find(&models.Game{}).Updates(toUpdate).Error; err != nil {
 		return nil, err
 	}
 
@@ -497,7 +734,57 @@ func (s *Server) SetMin(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 	toUpdate := models.Game{
 		Min: g.GetMin(),
 	}
-	if err := s.gormDb.Where("id = ?", game.GetId()).Find(game).Updates(toUpdate).Error; err != nil {
+	if err := s.gormDb.Where("id = ?", game.GetId()).Find(&models.Game{}).Updates(toUpdate).Error; err != nil {
+		return nil, err
+	}
+
+	out, err := s.GetGame(ctx, g)
+
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+
+}
+
+// SetBlinds sets an explicit small blind, big blind and minimum bet for a game.
+// Use this for structures which can't be derived from Min, such as 1/3 or 2/5
+func (s *Server) SetBlinds(ctx context.Context, g *pb.Game) (*pb.Game, error) {
+	if g.GetName() == "" {
+		return nil, ErrEmptyGameName
+	}
+
+	game, err := s.GetGame(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		return nil, ErrGameDoesntExist
+	}
+
+	if game.GetInRound() {
+		return nil, ErrGameInRound
+	}
+
+	if oneSidedBlinds(g) {
+		return nil, ErrOneSidedBlinds
+	}
+
+	if g.GetBigBlind() < g.GetSmallBlind() {
+		return nil, ErrInvalidBlinds
+	}
+
+	if g.GetMinBet() < 0 {
+		return nil, ErrInvalidMinBet
+	}
+
+	// use a map so zero values are persisted as well
+	toUpdate := map[string]interface{}{
+		"small_blind": g.GetSmallBlind(),
+		"big_blind":   g.GetBigBlind(),
+		"min_bet":     g.GetMinBet(),
+	}
+	if err := s.gormDb.Model(&models.Game{}).Where("id = ?", game.GetId()).Updates(toUpdate).Error; err != nil {
 		return nil, err
 	}
 
@@ -511,7 +798,7 @@ func (s *Server) SetMin(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 }
 
 func (s *Server) SetNextOnBet(ctx context.Context, in *pb.Round) (*pb.Round, error) {
-	r, err := s.GetRound(ctx, in)
+	r, err := s.getRound(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -532,8 +819,11 @@ func (s *Server) SetNextOnBet(ctx context.Context, in *pb.Round) (*pb.Round, err
 		return nil, err
 	}
 
-	nextAction, err := gr.NextInHand(p)
-
+	nextAction, err := gr.NextToAct(p)
+	if err == game_ring.ErrNoPlayerInHand {
+		// everyone else is all in, so there's no one left to act
+		return r, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -578,7 +868,7 @@ func (s *Server) NextDealer(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 		Dealer: newDealer.GetSlot(),
 	}
 
-	if err := s.gormDb.Where("id = ?", game.GetId()).Find(game).Updates(toUpdate).Error; err != nil {
+	if err := s.gormDb.Where("id = ?", game.GetId()).Find(&models.Game{}).Updates(toUpdate).Error; err != nil {
 		return nil, err
 	}
 
@@ -596,6 +886,8 @@ func (s *Server) NextDealer(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 //  1. Not enough, or too many players
 //  2. Slots are allocated to players incorrectly
 //  3. Button positions and bet is not set.
+//  4. Big blind is less than the small blind, or the minimum bet is negative
+//  5. Too many players for a stud game
 func (s *Server) ValidatePreGame(ctx context.Context, g *pb.Game) (*pb.Game, error) {
 	if g.InRound {
 		return nil, ErrGameInRound
@@ -609,10 +901,14 @@ func (s *Server) ValidatePreGame(ctx context.Context, g *pb.Game) (*pb.Game, err
 	// get a slice of all the player slots
 	slotList := []int64{}
 
+	if int64(len(g.GetPlayers().GetPlayers())) > game_ring.Seats(g) {
+		return g, ErrInvalidPlayerCount
+	}
+
 	for _, player := range g.GetPlayers().GetPlayers() {
 
-		// Only seats 1-8 are valid
-		if player.GetSlot() < 1 || player.GetSlot() > 8 {
+		// Only the table's seats are valid
+		if player.GetSlot() < 1 || player.GetSlot() > game_ring.Seats(g) {
 
 			return g, ErrInvalidSlotNumber
 		}
@@ -635,7 +931,7 @@ func (s *Server) ValidatePreGame(ctx context.Context, g *pb.Game) (*pb.Game, err
 		if !(i == 0) {
 			prev := slotList[i-1]
 			if !(prev < v) {
-				//The slots are not sequential, or there is a gap
+				//Two players are in the same slot, gaps between slots are empty seats
 
 				return g, ErrInvalidSlotNumber
 			}
@@ -645,14 +941,27 @@ func (s *Server) ValidatePreGame(ctx context.Context, g *pb.Game) (*pb.Game, err
 		}
 	}
 
+	if isStud(g.GetVariant()) && len(g.GetPlayers().GetPlayers()) > maxStudPlayers {
+		return g, ErrInvalidPlayerCount
+	}
+
 	if g.GetDealer() == 0 {
 		return g, ErrInvalidButtonAllocation
 	}
 
-	if g.GetMin() < 1 {
+	small, big := gameBlinds(g)
+	if small < 1 {
 		return g, ErrNoBetSet
 	}
 
+	if big < small {
+		return g, ErrInvalidBlinds
+	}
+
+	if g.GetMinBet() < 0 {
+		return g, ErrInvalidMinBet
+	}
+
 	return g, nil
 }
 
@@ -680,6 +989,16 @@ func (s *Server) RemovePlayerFromGame(ctx context.Context, player *pb.Player) (*
 	if err := s.gormDb.Where("player in (?)", player.GetId()).Delete(&models.GamePlayers{}).Error; err != nil {
 		return &empty.Empty{}, err
 	}
+	// the player gives up their seat, the longest waiting player takes it
+	if err := s.gormDb.Model(&models.Player{}).Where("id = ?", player.GetId()).Update("slot", 0).Error; err != nil {
+		return &empty.Empty{}, err
+	}
+	if err := s.seatFromWaitlist(ctx, int64(game.ID)); err != nil {
+		return &empty.Empty{}, err
+	}
+	if err := s.publishGame(ctx, int64(game.ID)); err != nil {
+		return &empty.Empty{}, err
+	}
 	return &empty.Empty{}, nil
 }
 
@@ -729,6 +1048,9 @@ func (s *Server) UpdateGameInRound(ctx context.Context, g *pb.Game) (*pb.Game, e
 	if err != nil {
 		return nil, err
 	}
+	if err := s.publishGame(ctx, out.GetId()); err != nil {
+		return nil, err
+	}
 	return out, nil
 }
 
@@ -741,7 +1063,7 @@ func (s *Server) UpdateRoundStatus(ctx context.Context, r *pb.Round) (*pb.Round,
 		return nil, err
 	}
 
-	out, err := s.GetRound(ctx, r)
+	out, err := s.getRound(ctx, r)
 
 	if err != nil {
 		return nil, err
@@ -763,6 +1085,12 @@ func (s *Server) UpdateGameStatus(ctx context.Context, g *pb.Game) (*pb.Game, er
 	if err != nil {
 		return nil, err
 	}
+	if out == nil {
+		return nil, nil
+	}
+	if err := s.publishGame(ctx, out.GetId()); err != nil {
+		return nil, err
+	}
 	return out, nil
 }
 
@@ -790,12 +1118,15 @@ func (s *Server) CreateRoundFromGame(ctx context.Context, g *pb.Game) (*pb.Round
 	gModel.ProtoUnMarshal(game)
 	// HydratePlayers
 	r := gModel.MarshalRound()
+	// the server seed is committed to before a client seed can be supplied to StartRound
+	r.ServerSeed = s.newServerSeed()
+	r.Commitment = deck.Commitment(r.ServerSeed)
 
 	if err := s.gormDb.Create(r).Error; err != nil {
 		return nil, err
 	}
 
-	round, err := s.GetRound(ctx, r.ProtoMarshal())
+	round, err := s.getRound(ctx, r.ProtoMarshal())
 	round.Players = g.GetPlayers()
 
 	round, err = s.CreateRoundPlayers(ctx, round)
@@ -821,7 +1152,7 @@ func (s *Server) CreateRoundPlayers(ctx context.Context, r *pb.Round) (*pb.Round
 		}
 	}
 
-	round, err := s.GetRound(ctx, r)
+	round, err := s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -830,7 +1161,7 @@ func (s *Server) CreateRoundPlayers(ctx context.Context, r *pb.Round) (*pb.Round
 
 func (s *Server) ValidatePreRound(ctx context.Context, r *pb.Round) (*pb.Round, error) {
 
-	round, err := s.GetRound(ctx, r)
+	round, err := s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -849,21 +1180,28 @@ func (s *Server) ValidatePreRound(ctx context.Context, r *pb.Round) (*pb.Round,
 		return nil, ErrInvalidPlayerCount
 	}
 
+	_, big := gameBlinds(game)
 	for _, p := range r.GetPlayers().GetPlayers() {
-		if p.Chips < game.GetMin()*2 {
+		if p.Chips < big {
 			return nil, ErrInsufficientChips
 		}
 	}
 
-	d := deck.Deck{}
-	d = d.Marshal(r.GetDeck())
+	if _, err := deck.ParseDeck(r.GetDeck()); err != nil {
+		return nil, err
+	}
 	return round, nil
 }
 
 // StartRound is executed to start and setup the round
 // Creates and deals a deck
 // deducts small/big blind and sets on bet to small blind
+// Rounds can't start while one of the players is spectating the game
 func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error) {
+	if err := s.seatedSpectator(ctx, r.GetGame()); err != nil {
+		return nil, err
+	}
+
 	r, err := s.CreateDeck(ctx, r)
 	if err != nil {
 		return nil, err
@@ -874,7 +1212,7 @@ func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error)
 		return nil, err
 	}
 
-	r.Status = pb.RoundStatus_PRE_FLOP
+	r.Status = firstBettingRound(r.GetVariant())
 
 	r, err = s.UpdateRoundStatus(ctx, r)
 	if err != nil {
@@ -900,11 +1238,21 @@ func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error)
 		return nil, err
 	}
 
+	// record the starting stacks before any chips go in
+	if err := s.snapshotRound(ctx, r, game); err != nil {
+		return nil, err
+	}
+
+	if isStud(r.GetVariant()) {
+		return s.postBringIn(ctx, r, game, ring)
+	}
+
 	big, small, err := ring.GetBigAndSmallBlind()
 
 	if err != nil {
 		return nil, err
 	}
+	smallChips, bigChips := gameBlinds(game)
 
 	r.Status = pb.RoundStatus_PRE_FLOP
 	// go to next position after big blind
@@ -920,7 +1268,7 @@ func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error)
 		Round:  r.GetId(),
 		Game:   r.GetGame(),
 		Player: small.GetId(),
-		Chips:  game.GetMin(),
+		Chips:  smallChips,
 		Type:   pb.Bet_SMALL,
 	}
 
@@ -929,7 +1277,7 @@ func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error)
 		Round:  r.GetId(),
 		Game:   r.GetGame(),
 		Player: big.GetId(),
-		Chips:  game.GetMin() * 2,
+		Chips:  bigChips,
 		Type:   pb.Bet_BIG,
 	}
 
@@ -940,7 +1288,7 @@ func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error)
 	if _, err := s.MakeBet(ctx, bigBet); err != nil {
 		return nil, err
 	}
-	r, err = s.GetRound(ctx, r)
+	r, err = s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -952,6 +1300,36 @@ func (s *Server) StartRound(ctx context.Context, r *pb.Round) (*pb.Round, error)
 	return r, nil
 }
 
+// dealBoard deals the board cards for the street
+func (s *Server) dealBoard(ctx context.Context, r *pb.Round, status pb.RoundStatus) (*pb.Round, error) {
+	var err error
+	switch status {
+	case pb.RoundStatus_FLOP:
+		r, err = s.DealFlop(ctx, r)
+	case pb.RoundStatus_RIVER:
+		r, err = s.DealRiver(ctx, r)
+	case pb.RoundStatus_TURN:
+		r, err = s.DealTurn(ctx, r)
+	default:
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cards := map[pb.RoundStatus]string{
+		pb.RoundStatus_FLOP:  r.GetFlop(),
+		pb.RoundStatus_RIVER: r.GetRiver(),
+		pb.RoundStatus_TURN:  r.GetTurn(),
+	}
+	return r, s.logEvent(&pb.HandEvent{
+		Game:   r.GetGame(),
+		Round:  r.GetId(),
+		Type:   pb.HandEventType_DEAL,
+		Status: status,
+		Cards:  cards[status],
+	})
+}
+
 func (s *Server) DealFlop(ctx context.Context, r *pb.Round) (*pb.Round, error) {
 
 	for _, p := range r.GetPlayers().GetPlayers() {
@@ -959,7 +1337,10 @@ func (s *Server) DealFlop(ctx context.Context, r *pb.Round) (*pb.Round, error) {
 			return nil, ErrNoExistingCards
 		}
 	}
-	d := deck.Deck{}.Marshal(r.GetDeck())
+	d, err := deck.ParseDeck(r.GetDeck())
+	if err != nil {
+		return nil, err
+	}
 
 	//burn one
 	_, d = deck.DealCard(d)
@@ -969,7 +1350,7 @@ func (s *Server) DealFlop(ctx context.Context, r *pb.Round) (*pb.Round, error) {
 	c3, d = deck.DealCard(d)
 
 	r.Flop = c1.String() + c2.String() + c3.String()
-	r, err := s.UpdateRoundFlop(ctx, r)
+	r, err = s.UpdateRoundFlop(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -991,11 +1372,14 @@ func (s *Server) DealRiver(ctx context.Context, r *pb.Round) (*pb.Round, error)
 			return nil, ErrNoExistingCards
 		}
 	}
-	r, err := s.GetRound(ctx, r)
+	r, err := s.getRound(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	d, err := deck.ParseDeck(r.GetDeck())
 	if err != nil {
 		return nil, err
 	}
-	d := deck.Deck{}.Marshal(r.GetDeck())
 
 	//burn one
 	_, d = deck.DealCard(d)
@@ -1024,11 +1408,14 @@ func (s *Server) DealTurn(ctx context.Context, r *pb.Round) (*pb.Round, error) {
 			return nil, ErrNoExistingCards
 		}
 	}
-	r, err := s.GetRound(ctx, r)
+	r, err := s.getRound(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	d, err := deck.ParseDeck(r.GetDeck())
 	if err != nil {
 		return nil, err
 	}
-	d := deck.Deck{}.Marshal(r.GetDeck())
 
 	//burn one
 	_, d = deck.DealCard(d)
@@ -1057,22 +1444,36 @@ func (s *Server) DealCards(ctx context.Context, r *pb.Round) (*pb.Round, error)
 			return nil, ErrExistingCards
 		}
 	}
-	d := deck.Deck{}
-	d = d.Marshal(r.GetDeck())
-	if !d.IsFull() {
+	d, err := deck.ParseDeck(r.GetDeck())
+	if err != nil {
+		return nil, err
+	}
+	if !d.IsFull(deckSize(r.GetVariant())) {
 		return nil, ErrDeckNotFull
 	}
 
-	//burn one
-	_, d = deck.DealCard(d)
-	var c1, c2 deck.Card
+	// stud is dealt without burn cards so 7 players have enough cards to reach seventh street
+	if !isStud(r.GetVariant()) {
+		//burn one
+		_, d = deck.DealCard(d)
+	}
+	var c deck.Card
 	for _, p := range r.GetPlayers().GetPlayers() {
-		c1, d = deck.DealCard(d)
-		c2, d = deck.DealCard(d)
-		p.Cards = c1.String() + c2.String()
+		hand := deck.Hand{}
+		for i := 0; i < holeCardCount(r.GetVariant()); i++ {
+			c, d = deck.DealCard(d)
+			hand = append(hand, c)
+		}
+		if isStud(r.GetVariant()) {
+			// third street is 2 down and 1 up
+			c, d = deck.DealCard(d)
+			hand = append(hand, c)
+			p.UpCards = c.String()
+		}
+		p.Cards = deck.Deck(hand).String()
 	}
 
-	_, err := s.UpdatePlayersCards(ctx, r.GetPlayers())
+	_, err = s.UpdatePlayersCards(ctx, r.GetPlayers())
 	if err != nil {
 		return nil, err
 	}
@@ -1084,7 +1485,10 @@ func (s *Server) DealCards(ctx context.Context, r *pb.Round) (*pb.Round, error)
 }
 
 func (s *Server) UpdateDeck(ctx context.Context, r *pb.Round) (*pb.Round, error) {
-	round, err := s.GetRound(ctx, r)
+	if _, err := deck.ParseDeck(r.GetDeck()); err != nil {
+		return nil, err
+	}
+	round, err := s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -1095,7 +1499,7 @@ func (s *Server) UpdateDeck(ctx context.Context, r *pb.Round) (*pb.Round, error)
 		"deck", round.GetDeck()).Error; err != nil {
 		return nil, err
 	}
-	round, err = s.GetRound(ctx, r)
+	round, err = s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -1104,7 +1508,7 @@ func (s *Server) UpdateDeck(ctx context.Context, r *pb.Round) (*pb.Round, error)
 }
 
 func (s *Server) SetAction(ctx context.Context, r *pb.Round) (*pb.Round, error) {
-	round, err := s.GetRound(ctx, r)
+	round, err := s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -1113,28 +1517,48 @@ func (s *Server) SetAction(ctx context.Context, r *pb.Round) (*pb.Round, error)
 		"action", r.GetAction()).Error; err != nil {
 		return nil, err
 	}
-	round, err = s.GetRound(ctx, r)
+	round, err = s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
 
 	return round, nil
 }
+
+// newServerSeed draws a new secret server seed for a round
+func (s *Server) newServerSeed() string {
+	if s.serverSeeds != nil {
+		return s.serverSeeds()
+	}
+	return deck.NewServerSeed(s.shuffler)
+}
+
+// CreateDeck shuffles a new deck for the round from the server seed committed to when
+// the round was created and the optional client seed
 func (s *Server) CreateDeck(ctx context.Context, r *pb.Round) (*pb.Round, error) {
-	round, err := s.GetRound(ctx, r)
+	clientSeed := r.GetClientSeed()
+	round, err := s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
-	d := deck.New()
-	d = deck.Shuffle(d)
+	// the server seed is kept off the round until it's over, so read it from the db
+	m := &models.Round{}
+	if err := s.gormDb.Where("id = ?", round.GetId()).Find(m).Error; err != nil {
+		return nil, err
+	}
+	if m.ServerSeed == "" {
+		return nil, ErrNoServerSeed
+	}
+	d := deck.FairShuffle(newDeck(round.GetVariant()), m.ServerSeed, clientSeed)
 
-	round.Deck = d.String()
-	out := &models.Round{}
-	if err := s.gormDb.Where("id = ?", round.GetId()).Find(out).Update(
-		"deck", round.GetDeck()).Error; err != nil {
+	toUpdate := map[string]interface{}{
+		"deck":        d.String(),
+		"client_seed": clientSeed,
+	}
+	if err := s.gormDb.Model(&models.Round{}).Where("id = ?", round.GetId()).Updates(toUpdate).Error; err != nil {
 		return nil, err
 	}
-	round, err = s.GetRound(ctx, r)
+	round, err = s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -1144,11 +1568,18 @@ func (s *Server) CreateDeck(ctx context.Context, r *pb.Round) (*pb.Round, error)
 
 func (s *Server) UpdatePlayersCards(ctx context.Context, in *pb.Players) (*pb.Players, error) {
 
+	for _, p := range in.GetPlayers() {
+		if _, err := deck.ParseHand(p.GetCards() + p.GetUpCards()); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, p := range in.GetPlayers() {
 		out := &models.Player{}
 		toUpdate := &models.Player{
-			Cards:  p.GetCards(),
-			InHand: true,
+			Cards:   p.GetCards(),
+			UpCards: p.GetUpCards(),
+			InHand:  true,
 		}
 		if err := s.gormDb.Where("id = ?", p.GetId()).Find(out).Updates(&toUpdate).Error; err != nil {
 			return nil, err
@@ -1165,13 +1596,16 @@ func (s *Server) UpdatePlayersCards(ctx context.Context, in *pb.Players) (*pb.Pl
 
 func (s *Server) UpdateRoundFlop(ctx context.Context, in *pb.Round) (*pb.Round, error) {
 
+	if _, err := deck.ParseHand(in.GetFlop()); err != nil {
+		return nil, err
+	}
 	out := &models.Round{}
 	if err := s.gormDb.Where("id = ?", in.GetId()).Find(out).Update(
 		"Flop", in.GetFlop()).Error; err != nil {
 		return nil, err
 	}
 
-	round, err := s.GetRound(ctx, in)
+	round, err := s.getRound(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -1179,13 +1613,16 @@ func (s *Server) UpdateRoundFlop(ctx context.Context, in *pb.Round) (*pb.Round,
 }
 func (s *Server) UpdateRoundRiver(ctx context.Context, in *pb.Round) (*pb.Round, error) {
 
+	if _, err := deck.ParseHand(in.GetRiver()); err != nil {
+		return nil, err
+	}
 	out := &models.Round{}
 	if err := s.gormDb.Where("id = ?", in.GetId()).Find(out).Update(
 		"River", in.GetRiver()).Error; err != nil {
 		return nil, err
 	}
 
-	round, err := s.GetRound(ctx, in)
+	round, err := s.getRound(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -1194,13 +1631,16 @@ func (s *Server) UpdateRoundRiver(ctx context.Context, in *pb.Round) (*pb.Round,
 
 func (s *Server) UpdateRoundTurn(ctx context.Context, in *pb.Round) (*pb.Round, error) {
 
+	if _, err := deck.ParseHand(in.GetTurn()); err != nil {
+		return nil, err
+	}
 	out := &models.Round{}
 	if err := s.gormDb.Where("id = ?", in.GetId()).Find(out).Update(
 		"Turn", in.GetTurn()).Error; err != nil {
 		return nil, err
 	}
 
-	round, err := s.GetRound(ctx, in)
+	round, err := s.getRound(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -1250,8 +1690,11 @@ func (s *Server) MakeBet(ctx context.Context, in *pb.Bet) (*pb.Round, error) {
 	if game.GetInRound() != true {
 		return nil, ErrGameIsNotInRound
 	}
+	if s.spectators.spectating(game.GetId(), in.GetPlayer()) {
+		return nil, ErrSpectating
+	}
 	// Get the round info needed to validate bet
-	r, err := s.GetRound(ctx, &pb.Round{Id: in.GetRound()})
+	r, err := s.getRound(ctx, &pb.Round{Id: in.GetRound()})
 	if err != nil {
 		return nil, err
 	}
@@ -1296,17 +1739,19 @@ func (s *Server) MakeBet(ctx context.Context, in *pb.Bet) (*pb.Round, error) {
 		}
 
 	case pb.Bet_CALL:
+		// a player without enough chips to call calls all in for the rest of their stack
+		toCall := tableMinBetRequired
+		if player.GetChips() < toCall {
+			toCall = player.GetChips()
+		}
 		if err := validateChips(
 			player.GetChips(),
 			in.GetChips(),
-			tableMinBetRequired); err != nil {
+			toCall); err != nil {
 			return nil, err
 		}
-		if in.GetChips() < tableMinBetRequired {
-			return nil, ErrInsufficientBet
-		}
 
-		if in.GetChips() != tableMinBetRequired {
+		if in.GetChips() != toCall {
 			return nil, ErrIncorrectBetForBetType
 		}
 
@@ -1320,6 +1765,39 @@ func (s *Server) MakeBet(ctx context.Context, in *pb.Bet) (*pb.Round, error) {
 		if in.GetChips() <= tableMinBetRequired {
 			return nil, ErrWrongBetType
 		}
+		streetBets, err := s.GetRoundBetsForStatus(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		// a player can go all in for less than a full raise
+		if in.GetChips()-tableMinBetRequired < minRaise(game, streetBets) && in.GetChips() != player.GetChips() {
+			return nil, ErrInsufficientRaise
+		}
+		if betLimit(game) == pb.BetLimit_POT_LIMIT {
+			pot, err := s.roundPot(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+			// a pot sized raise is a call followed by a bet the size of the pot after the call
+			if in.GetChips() > tableMinBetRequired*2+pot {
+				return nil, ErrExceedsPotLimit
+			}
+		}
+	case pb.Bet_BRING_IN:
+		// the bring in is only ever the forced first bet of third street, of the small blind
+		if r.GetStatus() != pb.RoundStatus_THIRD_STREET {
+			return nil, ErrWrongBetType
+		}
+		streetBets, err := s.GetRoundBetsForStatus(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if len(streetBets.GetBets()) != 0 {
+			return nil, ErrWrongBetType
+		}
+		if small, _ := gameBlinds(game); in.GetChips() != small {
+			return nil, ErrIncorrectBetForBetType
+		}
 	case pb.Bet_NONE:
 		return nil, ErrNoBetTypeSet
 	}
@@ -1338,8 +1816,20 @@ func (s *Server) MakeBet(ctx context.Context, in *pb.Bet) (*pb.Round, error) {
 	if err := s.gormDb.Create(toCreate).Error; err != nil {
 		return nil, err
 	}
+	if err := s.logEvent(&pb.HandEvent{
+		Game:   in.GetGame(),
+		Round:  in.GetRound(),
+		Type:   pb.HandEventType_BET,
+		Status: in.GetStatus(),
+		Player: player.GetId(),
+		Slot:   player.GetSlot(),
+		Chips:  in.GetChips(),
+		Bet:    in.GetType(),
+	}); err != nil {
+		return nil, err
+	}
 
-	r, err = s.GetRound(ctx, r)
+	r, err = s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -1377,7 +1867,7 @@ func (s *Server) MakeBet(ctx context.Context, in *pb.Bet) (*pb.Round, error) {
 }
 
 func (s *Server) SetNextRound(ctx context.Context, in *pb.Round) (*pb.Round, error) {
-	r, err := s.GetRound(ctx, &pb.Round{Id: in.GetId()})
+	r, err := s.getRound(ctx, &pb.Round{Id: in.GetId()})
 	if err != nil {
 		return nil, err
 	}
@@ -1393,6 +1883,10 @@ func (s *Server) SetNextRound(ctx context.Context, in *pb.Round) (*pb.Round, err
 		return nil, ErrIncompleteBets
 	}
 
+	if isAllIn(r) {
+		return s.runItOut(ctx, r)
+	}
+
 	rMap := map[pb.RoundStatus]pb.RoundStatus{
 		//pb.RoundStatus_NOT_STARTED: pb.RoundStatus_PRE_FLOP,
 		pb.RoundStatus_PRE_FLOP: pb.RoundStatus_FLOP,
@@ -1401,21 +1895,41 @@ func (s *Server) SetNextRound(ctx context.Context, in *pb.Round) (*pb.Round, err
 		pb.RoundStatus_TURN:     pb.RoundStatus_SHOW,
 		pb.RoundStatus_SHOW:     pb.RoundStatus_OVER,
 	}
+	if isStud(r.GetVariant()) {
+		rMap = studStreets
+	}
 	nextRound := rMap[r.GetStatus()]
 	r.Status = nextRound
 	r, err = s.UpdateRoundStatus(ctx, r)
 	if err != nil {
 		return nil, err
 	}
-	g, err := s.GetGame(ctx, &pb.Game{Id: r.GetGame()})
-	if err != nil {
+	if err := s.logEvent(&pb.HandEvent{Game: r.GetGame(), Round: r.GetId(), Type: pb.HandEventType_STREET, Status: nextRound}); err != nil {
 		return nil, err
 	}
-	ring, err := game_ring.NewRing(g)
-	if err != nil {
-		return nil, err
+
+	var nextUp *pb.Player
+	if isStudStreet(nextRound) {
+		// stud deals before betting, as the best hand showing acts first
+		r, err = s.DealStudStreet(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		nextUp, err = s.bestShowing(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		g, err := s.GetGame(ctx, &pb.Game{Id: r.GetGame()})
+		if err != nil {
+			return nil, err
+		}
+		ring, err := game_ring.NewRing(g)
+		if err != nil {
+			return nil, err
+		}
+		nextUp, err = ring.FirstToAct()
 	}
-	nextUp, err := ring.FirstOnBet()
 
 	r.Action = nextUp.GetSlot()
 	r, err = s.SetAction(ctx, r)
@@ -1425,18 +1939,8 @@ func (s *Server) SetNextRound(ctx context.Context, in *pb.Round) (*pb.Round, err
 
 	// We ignore status RoundStatus_SHOW, since we don't need to deal any cards
 	switch nextRound {
-	case pb.RoundStatus_FLOP:
-		r, err = s.DealFlop(ctx, r)
-		if err != nil {
-			return nil, err
-		}
-	case pb.RoundStatus_RIVER:
-		r, err = s.DealRiver(ctx, r)
-		if err != nil {
-			return nil, err
-		}
-	case pb.RoundStatus_TURN:
-		r, err = s.DealTurn(ctx, r)
+	case pb.RoundStatus_FLOP, pb.RoundStatus_RIVER, pb.RoundStatus_TURN:
+		r, err = s.dealBoard(ctx, r, nextRound)
 		if err != nil {
 			return nil, err
 		}
@@ -1469,7 +1973,7 @@ func (s *Server) SetNextRound(ctx context.Context, in *pb.Round) (*pb.Round, err
 		return s.UpdateRoundWinner(ctx, r)
 	}
 
-	r, err = s.GetRound(ctx, r)
+	r, err = s.getRound(ctx, r)
 	if err != nil {
 		return nil, err
 	}
@@ -1477,6 +1981,87 @@ func (s *Server) SetNextRound(ctx context.Context, in *pb.Round) (*pb.Round, err
 	return r, nil
 }
 
+// gameBlinds returns the small and big blind for a game.
+// Games without explicit blinds fall back to small = Min, big = Min*2
+func gameBlinds(g *pb.Game) (small, big int64) {
+	if g.GetSmallBlind() > 0 && g.GetBigBlind() > 0 {
+		return g.GetSmallBlind(), g.GetBigBlind()
+	}
+	return g.GetMin(), g.GetMin() * 2
+}
+
+// oneSidedBlinds reports whether only one of a game's blinds is set, gameBlinds would
+// otherwise ignore it and derive both from the game's min
+func oneSidedBlinds(g *pb.Game) bool {
+	return (g.GetSmallBlind() == 0) != (g.GetBigBlind() == 0)
+}
+
+// minRaise returns the least a raise has to add to the amount to call, the game's minimum bet
+// or the biggest raise made on the street so far. An all in for less than a full raise doesn't change it.
+func minRaise(g *pb.Game, bets *pb.Bets) int64 {
+	ordered := append([]*pb.Bet{}, bets.GetBets()...)
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].GetId() < ordered[j].GetId() })
+
+	least := g.GetMinBet()
+	bet := map[int64]int64{}
+	high := int64(0)
+	for _, b := range ordered {
+		bet[b.GetPlayer()] += b.GetChips()
+		if bet[b.GetPlayer()] <= high {
+			continue
+		}
+		if raise := bet[b.GetPlayer()] - high; b.GetType() == pb.Bet_RAISE && raise > least {
+			least = raise
+		}
+		high = bet[b.GetPlayer()]
+	}
+	return least
+}
+
+// holeCardCount returns how many cards each player is dealt for the variant
+func holeCardCount(v pb.GameVariant) int {
+	if isOmaha(v) {
+		return deck.OmahaHoleCards
+	}
+	return 2
+}
+
+// newDeck creates an unshuffled deck for the variant
+func newDeck(v pb.GameVariant) deck.Deck {
+	if v == pb.GameVariant_SHORT_DECK {
+		return deck.NewShort()
+	}
+	return deck.New()
+}
+
+func deckSize(v pb.GameVariant) int {
+	if v == pb.GameVariant_SHORT_DECK {
+		return deck.ShortDeckSize
+	}
+	return deck.FullDeckSize
+}
+
+func isOmaha(v pb.GameVariant) bool {
+	return v == pb.GameVariant_OMAHA || v == pb.GameVariant_OMAHA_HI_LO
+}
+
+// isHiLo returns true if the pot is split between the best high and best 8 or better low
+func isHiLo(v pb.GameVariant) bool {
+	return v == pb.GameVariant_HOLDEM_HI_LO || v == pb.GameVariant_OMAHA_HI_LO
+}
+
+// betLimit returns the betting structure of a game.
+// Games without a limit set use the variant default, pot limit for omaha and no limit otherwise
+func betLimit(g *pb.Game) pb.BetLimit {
+	if g.GetLimit() != pb.BetLimit_DEFAULT_LIMIT {
+		return g.GetLimit()
+	}
+	if isOmaha(g.GetVariant()) {
+		return pb.BetLimit_POT_LIMIT
+	}
+	return pb.BetLimit_NO_LIMIT
+}
+
 func validateChips(bank, bet, min int64) error {
 	if bet < min {
 		return ErrInsufficientBet
@@ -1562,7 +2147,7 @@ func (s *Server) GetAmountToCallForPlayer(ctx context.Context, in *pb.AmountToCa
 // maybe TODO: refactor so the input is a pre-inflated value and we dont have to re-inflate.
 // Perhaps a RoundState wrapper proto that can just be passed around?
 func (s *Server) IsBettingOver(ctx context.Context, in *pb.AmountToCall) (*pb.AmountToCall, error) {
-	r, err := s.GetRound(ctx, in.GetRound())
+	r, err := s.getRound(ctx, in.GetRound())
 	if err != nil {
 		return nil, err
 	}
@@ -1578,51 +2163,36 @@ func (s *Server) IsBettingOver(ctx context.Context, in *pb.AmountToCall) (*pb.Am
 		return nil, err
 	}
 
-	activePlayers := 0
-
-	for _, p := range players.GetPlayers() {
-		if p.GetInHand() {
-			activePlayers += 1
-		}
-	}
-
 	liveBetMap := map[int64]int64{}
 
 	for _, i := range bets.GetBets() {
 		switch i.Type {
-		case pb.Bet_CALL, pb.Bet_RAISE, pb.Bet_BIG, pb.Bet_SMALL:
+		case pb.Bet_CALL, pb.Bet_RAISE, pb.Bet_BIG, pb.Bet_SMALL, pb.Bet_BRING_IN:
 			liveBetMap[i.GetPlayer()] += i.GetChips()
 		}
 	}
 
 	// get player with biggest bet:
 	bigBet := int64(0)
-	uniqueBets := 0
 	for _, v := range liveBetMap {
 		if v > bigBet {
 			bigBet = v
 		}
-		uniqueBets += 1
-	}
-
-	// If we don't have the min # of bets of active players than give up
-	if activePlayers > uniqueBets {
-		in.BettingOver = false
-		return in, nil
 	}
 
-	for _, v := range liveBetMap {
-		if v != bigBet {
+	// every player in hand with chips left has to have bet, and matched the biggest bet.
+	// Players all in can't bet any more, so they're done even if they bet less
+	for _, p := range players.GetPlayers() {
+		if !p.GetInHand() || p.GetChips() == 0 {
+			continue
+		}
+		if v, ok := liveBetMap[p.GetId()]; !ok || v != bigBet {
 			in.BettingOver = false
 			return in, nil
 		}
 	}
 
-	if activePlayers == uniqueBets {
-		in.BettingOver = true
-		return in, nil
-	}
-
+	in.BettingOver = true
 	return in, nil
 }
 
@@ -1651,6 +2221,11 @@ func (s *Server) UpdateRoundWinner(ctx context.Context, r *pb.Round) (*pb.Round,
 		return nil, err
 	}
 
+	r, err = s.SettlePot(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
 	g.InRound = false
 
 	g, err = s.UpdateGameStatus(ctx, g)
@@ -1662,6 +2237,222 @@ func (s *Server) UpdateRoundWinner(ctx context.Context, r *pb.Round) (*pb.Round,
 
 }
 
+// SettlePot pays out the chips bet in the round to the best hand still in the hand.
+// The house rake, if any, is taken and recorded before the pot is paid out.
+// When players are all in for different amounts each side pot is paid to the best hand that bet into it.
+// Tied hands split the pot, with any odd chips going to the first winner.
+// expects an evaluated round
+func (s *Server) SettlePot(ctx context.Context, r *pb.Round) (*pb.Round, error) {
+	g, err := s.GetGame(ctx, &pb.Game{Id: r.GetGame()})
+	if err != nil {
+		return nil, err
+	}
+
+	bets, err := s.GetRoundBets(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	pot := int64(0)
+	for _, b := range bets.GetBets() {
+		pot += b.GetChips()
+	}
+
+	winners := roundWinners(r)
+	if len(winners) < 1 {
+		return nil, ErrNoWinningPlayer
+	}
+	// folded players can't win the pot, so make sure the recorded winner is still in hand
+	r.WinningPlayer = winners[0].GetId()
+	r.WinningScore = winners[0].GetScore()
+	r.WinningHand = winners[0].GetCards() + r.GetFlop() + r.GetRiver() + r.GetTurn()
+	setWinningDescription(r, winners[0])
+
+	rake := &models.Rake{
+		Game:  r.GetGame(),
+		Round: r.GetId(),
+		Pot:   pot,
+		Chips: calculateRake(g, r, pot),
+	}
+	// only raked hands go in the ledger
+	if rake.Chips > 0 {
+		if err := s.gormDb.Create(rake).Error; err != nil {
+			return nil, err
+		}
+	}
+	pots := sidePots(r, bets)
+	// the rake comes out of the main pot first
+	for i, left := 0, rake.Chips; i < len(pots) && left > 0; i++ {
+		take := left
+		if pots[i].chips < take {
+			take = pots[i].chips
+		}
+		pots[i].chips -= take
+		left -= take
+	}
+
+	// when the board was run more than once each board wins an equal share of each pot
+	runs := []*pb.Round{r}
+	if len(r.GetRunBoards()) > 1 {
+		extra, err := s.evaluateRuns(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, extra...)
+	}
+
+	awards := map[int64]int64{}
+	for p, sp := range pots {
+		share := sp.chips / int64(len(runs))
+		for i, run := range runs {
+			chips := share
+			if i == 0 {
+				// the first board takes any odd chips
+				chips += sp.chips % int64(len(runs))
+			}
+			lowWinners := awardPot(chips, sp.contested(run), awards)
+			if p == 0 && i == 0 && len(lowWinners) > 0 {
+				r.WinningLowPlayer = lowWinners[0].GetId()
+				r.WinningLowScore = lowWinners[0].GetLowScore()
+			}
+		}
+	}
+
+	// pay out in a consistent order, each player once even if they won more than one pot
+	ids := []int64{}
+	for id := range awards {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		// get the latest chip count since bets have been deducted
+		player, err := s.GetPlayer(ctx, &pb.Player{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		player.Chips += awards[id]
+		if _, err := s.UpdatePlayersChips(ctx, &pb.Players{Players: []*pb.Player{player}}); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.recordAwards(ctx, r, awards); err != nil {
+		return nil, err
+	}
+	if err := s.recordPlayerHands(ctx, r, g, awards); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// awardPot splits the chips between an evaluated round's best high hands, and best lows in hi-lo games.
+// returns the low winners
+func awardPot(chips int64, r *pb.Round, awards map[int64]int64) []*pb.Player {
+	winners := roundWinners(r)
+	lowWinners := roundLowWinners(r)
+	if len(lowWinners) > 0 {
+		// high takes the odd chip when the pot is split between high and low
+		low := chips / 2
+		splitPot(chips-low, winners, awards)
+		splitPot(low, lowWinners, awards)
+	} else {
+		splitPot(chips, winners, awards)
+	}
+	return lowWinners
+}
+
+func setWinningDescription(r *pb.Round, winner *pb.Player) {
+	r.WinningCategory = winner.GetHandCategory()
+	r.WinningHandName = winner.GetHandName()
+	r.WinningBestHand = winner.GetBestHand()
+}
+
+// splitPot divides chips evenly between the winners, with any odd chips going to the first winner.
+// Splitting a half pot between tied hands quarters it.
+func splitPot(chips int64, winners []*pb.Player, awards map[int64]int64) {
+	share := chips / int64(len(winners))
+	odd := chips % int64(len(winners))
+	for i, w := range winners {
+		awards[w.GetId()] += share
+		if i == 0 {
+			awards[w.GetId()] += odd
+		}
+	}
+}
+
+// roundPot returns the total chips bet in the round
+func (s *Server) roundPot(ctx context.Context, r *pb.Round) (int64, error) {
+	bets, err := s.GetRoundBets(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	pot := int64(0)
+	for _, b := range bets.GetBets() {
+		pot += b.GetChips()
+	}
+	return pot, nil
+}
+
+// roundWinners returns the players in hand with the best score.
+// If only one player is left in hand they win regardless of score.
+func roundWinners(r *pb.Round) []*pb.Player {
+	inHand := []*pb.Player{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetInHand() {
+			inHand = append(inHand, p)
+		}
+	}
+	if len(inHand) < 2 {
+		return inHand
+	}
+
+	best := inHand[0].GetScore()
+	for _, p := range inHand {
+		if p.GetScore() < best {
+			best = p.GetScore()
+		}
+	}
+
+	winners := []*pb.Player{}
+	for _, p := range inHand {
+		if p.GetScore() == best {
+			winners = append(winners, p)
+		}
+	}
+	return winners
+}
+
+// roundLowWinners returns the players in hand with the best qualifying low.
+// No one wins the low if only one player is left in hand, since they take the whole pot.
+func roundLowWinners(r *pb.Round) []*pb.Player {
+	inHand := []*pb.Player{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetInHand() {
+			inHand = append(inHand, p)
+		}
+	}
+	if len(inHand) < 2 {
+		return nil
+	}
+
+	best := uint32(0)
+	for _, p := range inHand {
+		if p.GetLowScore() > 0 && (best == 0 || p.GetLowScore() < best) {
+			best = p.GetLowScore()
+		}
+	}
+	if best == 0 {
+		return nil
+	}
+
+	winners := []*pb.Player{}
+	for _, p := range inHand {
+		if p.GetLowScore() == best {
+			winners = append(winners, p)
+		}
+	}
+	return winners
+}
+
 func (s *Server) EvaluateHands(ctx context.Context, round *pb.Round) (*pb.Round, error) {
 	// expects an inflated round
 	players := round.GetPlayers()
@@ -1672,10 +2463,43 @@ func (s *Server) EvaluateHands(ctx context.Context, round *pb.Round) (*pb.Round,
 
 	handsToRank := make(deck.PlayerHands, len(players.GetPlayers()))
 
+	board, err := deck.ParseHand(round.GetFlop() + round.GetRiver() + round.GetTurn())
+	if err != nil {
+		return nil, err
+	}
+
 	for _, player := range players.GetPlayers() {
 
-		hand := deck.NewHand(player.GetCards() + round.GetFlop() + round.GetRiver() + round.GetTurn())
-		score := hand.EvaluateHand()
+		var score, low uint32
+		var hasLow bool
+		hand, err := deck.ParseHand(player.GetCards())
+		if err != nil {
+			return nil, err
+		}
+		var description deck.HandDescription
+		if isOmaha(round.GetVariant()) {
+			score = hand.EvaluateOmaha(board)
+			low, hasLow = hand.EvaluateOmahaLow(board)
+			description = hand.DescribeOmaha(board)
+		} else if round.GetVariant() == pb.GameVariant_RAZZ {
+			score = hand.EvaluateRazz()
+			description = hand.DescribeRazz()
+		} else if round.GetVariant() == pb.GameVariant_SHORT_DECK {
+			hand = append(hand, board...)
+			score = hand.EvaluateShortDeck()
+			description = hand.DescribeShortDeck()
+		} else {
+			hand = append(hand, board...)
+			score = hand.EvaluateHand()
+			low, hasLow = hand.EvaluateLow()
+			description = hand.DescribeHand()
+		}
+		player.HandCategory = pb.HandCategory(description.Category)
+		player.HandName = description.Name
+		player.BestHand = description.Cards.String()
+		if isHiLo(round.GetVariant()) && hasLow {
+			player.LowScore = low
+		}
 		// set the score on response
 		player.Score = score
 		playerHand := deck.PlayerHand{
@@ -1707,6 +2531,7 @@ func (s *Server) EvaluateHands(ctx context.Context, round *pb.Round) (*pb.Round,
 	round.WinningPlayer = winner.GetId()
 	round.WinningScore = winner.GetScore()
 	round.WinningHand = winner.GetCards() + round.GetFlop() + round.GetRiver() + round.GetTurn()
+	setWinningDescription(round, winner)
 	round.Action = 0
 
 	return round, nil
@@ -1720,6 +2545,12 @@ func statusIsValidForBet(status pb.RoundStatus) bool {
 		pb.RoundStatus_RIVER:    true,
 		pb.RoundStatus_TURN:     true,
 		pb.RoundStatus_SHOW:     true,
+
+		pb.RoundStatus_THIRD_STREET:   true,
+		pb.RoundStatus_FOURTH_STREET:  true,
+		pb.RoundStatus_FIFTH_STREET:   true,
+		pb.RoundStatus_SIXTH_STREET:   true,
+		pb.RoundStatus_SEVENTH_STREET: true,
 	}
 	if s := valid[status]; s {
 		return true
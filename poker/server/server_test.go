@@ -11,10 +11,12 @@ import (
 	pb "grpc_texas_holdem/poker/protobufs"
 	"grpc_texas_holdem/poker/server"
 	"grpc_texas_holdem/poker/server/game_ring"
+	"io"
 	"log"
 	"math/rand"
 	"net"
 	"os"
+	"sort"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -56,7 +58,7 @@ func init() {
 	connection, clientApp := client.CreateConnectionClient()
 	testClient = clientApp
 	testConnection = connection
-	defer os.Remove(fmt.Sprintf("./%s.db", testDatabase))
+	defer removeTestDatabase(testDatabase)
 }
 
 func TestMain(m *testing.M) {
@@ -64,11 +66,17 @@ func TestMain(m *testing.M) {
 	s := m.Run()
 	// Cleanup connections and remove test database
 	testConnection.Close()
-	os.Remove(fmt.Sprintf("./%s.db", testDatabase))
+	removeTestDatabase(testDatabase)
 	os.Exit(s)
 
 }
 
+// removeTestDatabase removes a test database along with any journal sqlite left behind
+func removeTestDatabase(name string) {
+	os.Remove(fmt.Sprintf("./%s.db", name))
+	os.Remove(fmt.Sprintf("./%s.db-journal", name))
+}
+
 // runTestServer is the same server is used in all tests
 func runTestServer(name string) {
 	lis, err := net.Listen("tcp", server.Port)
@@ -87,6 +95,28 @@ func runTestServer(name string) {
 
 }
 
+// seededTestClient starts a server of its own dealing from a seeded shuffler, so each seed
+// deals the same hands in the same order
+func seededTestClient(t *testing.T, seed int64) pb.PokerClient {
+	name := fmt.Sprintf("test_seeded_%d", rand.Int63())
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	serv, err := server.NewServer(name, server.WithShuffler(deck.NewSeededShuffler(seed)))
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	pb.RegisterPokerServer(s, serv)
+	go s.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+		removeTestDatabase(name)
+	})
+	return pb.NewPokerClient(conn)
+}
+
 func TestServer_CreatePlayer(t *testing.T) {
 	testPlayer := getUniqueName()
 
@@ -1222,6 +1252,199 @@ func TestServer_ValidatePreGame(t *testing.T) {
 	}
 }
 
+func TestServer_SetBlinds(t *testing.T) {
+	var playersSetA = []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}
+
+	tests := []struct {
+		Name            string
+		PlayersToCreate *pb.Players
+		GameToCreate    *pb.Game
+		SmallBlind      int64
+		BigBlind        int64
+		MinBet          int64
+		ExpError        string
+		bet1            []betTest
+	}{
+		{
+			Name: "Blinds of 1/3 with a minimum bet of 3",
+			PlayersToCreate: &pb.Players{
+				Players: playersSetA,
+			},
+			GameToCreate: &pb.Game{
+				Name: getUniqueName(),
+				Players: &pb.Players{
+					Players: playersSetA,
+				},
+				SmallBlind: 1,
+				BigBlind:   3,
+				MinBet:     3,
+			},
+			SmallBlind: 1,
+			BigBlind:   3,
+			MinBet:     3,
+			bet1: []betTest{
+				{
+					bet: &pb.Bet{
+						Chips:  4,
+						Type:   pb.Bet_RAISE,
+						Status: pb.RoundStatus_PRE_FLOP,
+					},
+					err: rpcError(server.ErrInsufficientRaise.Error()),
+				},
+				{
+					bet: &pb.Bet{
+						Chips:  6,
+						Type:   pb.Bet_RAISE,
+						Status: pb.RoundStatus_PRE_FLOP,
+					},
+					err: "",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			round, bets, readyGame := setupGame(t, tt.PlayersToCreate, tt.GameToCreate)
+			require.Equal(t, tt.SmallBlind, readyGame.GetSmallBlind())
+			require.Equal(t, tt.BigBlind, readyGame.GetBigBlind())
+			require.Equal(t, tt.MinBet, readyGame.GetMinBet())
+
+			b1 := bets.GetBets()[0]
+			require.Equal(t, pb.Bet_SMALL, b1.GetType())
+			require.Equal(t, tt.SmallBlind, b1.GetChips())
+			b2 := bets.GetBets()[1]
+			require.Equal(t, pb.Bet_BIG, b2.GetType())
+			require.Equal(t, tt.BigBlind, b2.GetChips())
+
+			p, err := testClient.GetPlayerOnBet(ctx, round)
+			require.NoError(t, err)
+			makeAndEvaluateBet(t, ctx, round, readyGame, p, tt.bet1)
+
+			// blinds can't be changed while the game is in a round
+			readyGame.SmallBlind = 5
+			readyGame.BigBlind = 2
+			_, err = testClient.SetBlinds(ctx, readyGame)
+			require.Equal(t, rpcError(server.ErrGameInRound.Error()), err.Error())
+		})
+	}
+
+	t.Run("Big blind less than small blind", func(t *testing.T) {
+		ctx := context.Background()
+		game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+		require.NoError(t, err)
+
+		game.SmallBlind = 5
+		game.BigBlind = 2
+		_, err = testClient.SetBlinds(ctx, game)
+		require.Equal(t, rpcError(server.ErrInvalidBlinds.Error()), err.Error())
+
+		game.SmallBlind = 2
+		game.BigBlind = 5
+		game.MinBet = 5
+		game, err = testClient.SetBlinds(ctx, game)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), game.GetSmallBlind())
+		require.Equal(t, int64(5), game.GetBigBlind())
+		require.Equal(t, int64(5), game.GetMinBet())
+	})
+
+	t.Run("Only one blind set", func(t *testing.T) {
+		ctx := context.Background()
+		_, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), BigBlind: 10})
+		require.EqualError(t, err, rpcError(server.ErrOneSidedBlinds.Error()))
+
+		game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+		require.NoError(t, err)
+		game.BigBlind = 10
+		_, err = testClient.SetBlinds(ctx, game)
+		require.EqualError(t, err, rpcError(server.ErrOneSidedBlinds.Error()))
+		game.BigBlind = 0
+		game.SmallBlind = 5
+		_, err = testClient.SetBlinds(ctx, game)
+		require.EqualError(t, err, rpcError(server.ErrOneSidedBlinds.Error()))
+	})
+
+	t.Run("Raise at least the last raise", func(t *testing.T) {
+		ctx := context.Background()
+		players := []*pb.Player{
+			{Name: getUniqueName(), Chips: 1000},
+			{Name: getUniqueName(), Chips: 1000},
+			{Name: getUniqueName(), Chips: 1000},
+		}
+		round, _, readyGame := setupGameWithDealer(t, &pb.Players{Players: players}, &pb.Game{
+			Name:       getUniqueName(),
+			Players:    &pb.Players{Players: players},
+			SmallBlind: 10,
+			BigBlind:   20,
+			MinBet:     20,
+		}, 1)
+		p, err := testClient.GetPlayerOnBet(ctx, round)
+		require.NoError(t, err)
+
+		// the button raises 80 more than the big blind, so the small blind has to raise by 80 as well
+		_, round, p = makeAndEvaluateBet(t, ctx, round, readyGame, p, []betTest{
+			{
+				bet: &pb.Bet{Chips: 100, Type: pb.Bet_RAISE, Status: pb.RoundStatus_PRE_FLOP},
+			},
+		})
+		makeAndEvaluateBet(t, ctx, round, readyGame, p, []betTest{
+			{
+				bet: &pb.Bet{Chips: 90 + 79, Type: pb.Bet_RAISE, Status: pb.RoundStatus_PRE_FLOP},
+				err: rpcError(server.ErrInsufficientRaise.Error()),
+			},
+			{
+				bet: &pb.Bet{Chips: 90 + 80, Type: pb.Bet_RAISE, Status: pb.RoundStatus_PRE_FLOP},
+			},
+		})
+	})
+
+	t.Run("All in for less than a full raise", func(t *testing.T) {
+		ctx := context.Background()
+		// the first player is seated on the button, which is first to act three handed
+		players := []*pb.Player{
+			{Name: getUniqueName(), Chips: 30},
+			{Name: getUniqueName(), Chips: 1000},
+			{Name: getUniqueName(), Chips: 1000},
+		}
+		round, _, readyGame := setupGameWithDealer(t, &pb.Players{Players: players}, &pb.Game{
+			Name:       getUniqueName(),
+			Players:    &pb.Players{Players: players},
+			SmallBlind: 10,
+			BigBlind:   20,
+			MinBet:     20,
+		}, 1)
+		p, err := testClient.GetPlayerOnBet(ctx, round)
+		require.NoError(t, err)
+		require.Equal(t, int64(30), p.GetChips())
+
+		makeAndEvaluateBet(t, ctx, round, readyGame, p, []betTest{
+			{
+				bet: &pb.Bet{Chips: 25, Type: pb.Bet_RAISE, Status: pb.RoundStatus_PRE_FLOP},
+				err: rpcError(server.ErrInsufficientRaise.Error()),
+			},
+			{
+				bet: &pb.Bet{Chips: 30, Type: pb.Bet_RAISE, Status: pb.RoundStatus_PRE_FLOP},
+				err: "",
+			},
+		})
+	})
+}
+
 func TestServer_DeletePlayers(t *testing.T) {
 
 	tests := []struct {
@@ -2396,6 +2619,13 @@ func makeAndEvaluateBet(t *testing.T, ctx context.Context, round *pb.Round, read
 }
 
 func setupGame(t *testing.T, players *pb.Players, inGame *pb.Game) (*pb.Round, *pb.Bets, *pb.Game) {
+	return setupGameWithDealer(t, players, inGame, 0)
+}
+
+// setupGameWithDealer is setupGame with the button moved to the dealer's seat, 0 leaves it in the seat
+// it's randomly put in. Cards are dealt in seat order, so a seeded deck deals the same hands to the
+// same positions once the button is fixed
+func setupGameWithDealer(t *testing.T, players *pb.Players, inGame *pb.Game, dealer int64) (*pb.Round, *pb.Bets, *pb.Game) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
@@ -2424,6 +2654,11 @@ func setupGame(t *testing.T, players *pb.Players, inGame *pb.Game) (*pb.Round, *
 	require.NoError(t, err)
 	buttonSetGame, err := testClient.SetButtonPositions(ctx, allocatedGame)
 	require.NoError(t, err)
+	for i := 0; dealer != 0 && buttonSetGame.GetDealer() != dealer; i++ {
+		require.Less(t, i, len(buttonSetGame.GetPlayers().GetPlayers()))
+		buttonSetGame, err = testClient.NextDealer(ctx, buttonSetGame)
+		require.NoError(t, err)
+	}
 	buttonSetGame.Min = minChips
 	readyGame, err := testClient.SetMin(ctx, buttonSetGame)
 	require.NoError(t, err)
@@ -2449,14 +2684,17 @@ func setupGame(t *testing.T, players *pb.Players, inGame *pb.Game) (*pb.Round, *
 	round, err = testClient.StartRound(ctx, round)
 	require.NoError(t, err)
 
+	// small and big blind, or the bring in for stud games
+	expBets := 2
+	if v := inGame.GetVariant(); v == pb.GameVariant_SEVEN_CARD_STUD || v == pb.GameVariant_RAZZ {
+		expBets = 1
+	}
 	bets, err := testClient.GetRoundBets(ctx, round)
 	require.NoError(t, err)
-	// big and small blinds
-	require.Equal(t, 2, len(bets.GetBets()))
+	require.Equal(t, expBets, len(bets.GetBets()))
 	bets, err = testClient.GetRoundBetsForStatus(ctx, round)
 	require.NoError(t, err)
-	// small and big blind
-	require.Equal(t, 2, len(bets.GetBets()))
+	require.Equal(t, expBets, len(bets.GetBets()))
 	return round, bets, readyGame
 }
 
@@ -2497,6 +2735,7 @@ func TestServer_EvaluateHands(t *testing.T) {
 		Players  *pb.Players
 		Count    int
 		TopScore uint32
+		TopName  string
 	}{
 		{
 			Name: "1 player with royal flush",
@@ -2513,6 +2752,7 @@ func TestServer_EvaluateHands(t *testing.T) {
 
 			Count:    1,
 			TopScore: 1,
+			TopName:  "Royal flush",
 		},
 		{
 			Name: "test another hand",
@@ -2529,6 +2769,7 @@ func TestServer_EvaluateHands(t *testing.T) {
 
 			Count:    1,
 			TopScore: 1600,
+			TopName:  "Straight, Ace high",
 		},
 		{
 			Name: "Multiple players",
@@ -2553,6 +2794,7 @@ func TestServer_EvaluateHands(t *testing.T) {
 			},
 			Count:    3,
 			TopScore: 50, // 4 jacks = 50
+			TopName:  "Four of a kind, Jacks",
 		},
 	}
 
@@ -2571,8 +2813,2191 @@ func TestServer_EvaluateHands(t *testing.T) {
 
 			topPlayer := players.GetPlayers().GetPlayers()[0]
 			require.Equal(t, int(tt.TopScore), int(topPlayer.GetScore()))
+			require.Equal(t, tt.TopName, topPlayer.GetHandName())
+			require.Equal(t, pb.HandCategory(deck.Category(tt.TopScore)), topPlayer.GetHandCategory())
+			require.Len(t, topPlayer.GetBestHand(), 10)
+			require.Equal(t, tt.TopName, players.GetWinningHandName())
+
+		})
+	}
+
+}
+
+// playToShowdown checks/calls every bet until the round is over
+func playToShowdown(t *testing.T, ctx context.Context, round *pb.Round) *pb.Round {
+	return playToStatus(t, ctx, round, pb.RoundStatus_OVER)
+}
+
+// playToStatus checks/calls every bet until the round reaches the status or is over
+func playToStatus(t *testing.T, ctx context.Context, round *pb.Round, status pb.RoundStatus) *pb.Round {
+	for {
+		round, err := testClient.GetRound(ctx, &pb.Round{Id: round.GetId()})
+		require.NoError(t, err)
+		if round.GetStatus() == status || round.GetStatus() == pb.RoundStatus_OVER {
+			return round
+		}
+		p, err := testClient.GetPlayerOnBet(ctx, round)
+		require.NoError(t, err)
+		amt, err := testClient.GetAmountToCallForPlayer(ctx, &pb.AmountToCall{Player: p, Round: round})
+		require.NoError(t, err)
+		// a short stack calls all in
+		if amt.GetChips() > p.GetChips() {
+			amt.Chips = p.GetChips()
+		}
+		_, err = testClient.MakeBet(ctx, &pb.Bet{
+			Player: p.GetId(),
+			Game:   round.GetGame(),
+			Round:  round.GetId(),
+			Chips:  amt.GetChips(),
+			Type:   pb.Bet_CALL,
+			Status: round.GetStatus(),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestServer_Rake(t *testing.T) {
+
+	tests := []struct {
+		Name        string
+		RakePercent float64
+		RakeCap     int64
+		ExpRake     int64
+		ExpError    string
+	}{
+		{
+			Name:        "10 percent rake",
+			RakePercent: 10,
+			ExpRake:     4,
+		},
+		{
+			Name:        "10 percent rake capped at 3",
+			RakePercent: 10,
+			RakeCap:     3,
+			ExpRake:     3,
+		},
+		{
+			Name:        "No rake",
+			RakePercent: 0,
+			ExpRake:     0,
+		},
+		{
+			Name:        "Invalid rake",
+			RakePercent: 101,
+			ExpError:    rpcError(server.ErrInvalidRake.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			players := []*pb.Player{
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+			}
+			game := &pb.Game{
+				Name:        getUniqueName(),
+				Players:     &pb.Players{Players: players},
+				RakePercent: tt.RakePercent,
+				RakeCap:     tt.RakeCap,
+			}
+			if tt.ExpError != "" {
+				game, err := testClient.CreateGame(ctx, &pb.Game{Name: game.GetName()})
+				require.NoError(t, err)
+				game.RakePercent = tt.RakePercent
+				game.RakeCap = tt.RakeCap
+				_, err = testClient.SetRake(ctx, game)
+				require.Equal(t, tt.ExpError, err.Error())
+				return
+			}
+
+			round, _, readyGame := setupGame(t, &pb.Players{Players: players}, game)
+			// blinds of 10/20 are called and checked down so the pot is 40
+			round = playToShowdown(t, ctx, round)
+			require.NotZero(t, round.GetWinningPlayer())
+
+			roundPlayers, err := testClient.GetRoundPlayersByRoundId(ctx, round)
+			require.NoError(t, err)
+			total := int64(0)
+			for _, p := range roundPlayers.GetPlayers() {
+				total += p.GetChips()
+			}
+			require.Equal(t, 2000-tt.ExpRake, total)
+
+			report, err := testClient.GetRakeReport(ctx, &pb.RakeReport{Game: readyGame.GetId()})
+			require.NoError(t, err)
+			require.Equal(t, tt.ExpRake, report.GetChips())
+			if tt.ExpRake == 0 {
+				// hands without rake aren't in the ledger
+				require.Empty(t, report.GetGames())
+				require.Empty(t, report.GetDays())
+				return
+			}
+			require.Equal(t, 1, len(report.GetGames()))
+			require.Equal(t, int64(1), report.GetGames()[0].GetHands())
+			require.Equal(t, tt.ExpRake, report.GetGames()[0].GetChips())
+			require.Equal(t, 1, len(report.GetDays()))
+		})
+	}
+}
+
+func TestServer_Omaha(t *testing.T) {
+	var playersSetA = []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}
+
+	tests := []struct {
+		Name            string
+		PlayersToCreate *pb.Players
+		GameToCreate    *pb.Game
+		bet1            []betTest
+	}{
+		{
+			Name: "Pot limit omaha",
+			PlayersToCreate: &pb.Players{
+				Players: playersSetA,
+			},
+			GameToCreate: &pb.Game{
+				Name: getUniqueName(),
+				Players: &pb.Players{
+					Players: playersSetA,
+				},
+				Variant: pb.GameVariant_OMAHA,
+			},
+			// blinds are 10/20 so the pot is 30 and a pot sized raise is 20 + (30 + 20)
+			bet1: []betTest{
+				{
+					bet: &pb.Bet{
+						Chips:  71,
+						Type:   pb.Bet_RAISE,
+						Status: pb.RoundStatus_PRE_FLOP,
+					},
+					err: rpcError(server.ErrExceedsPotLimit.Error()),
+				},
+				{
+					bet: &pb.Bet{
+						Chips:  70,
+						Type:   pb.Bet_RAISE,
+						Status: pb.RoundStatus_PRE_FLOP,
+					},
+					err: "",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			round, _, readyGame := setupGame(t, tt.PlayersToCreate, tt.GameToCreate)
+			require.Equal(t, pb.GameVariant_OMAHA, readyGame.GetVariant())
+			require.Equal(t, pb.GameVariant_OMAHA, round.GetVariant())
+
+			// 4 hole cards (2x character per card)
+			roundPlayers, err := testClient.GetRoundPlayersByRoundId(ctx, round)
+			require.NoError(t, err)
+			for _, p := range roundPlayers.GetPlayers() {
+				require.Equal(t, 8, len(p.GetCards()))
+			}
+
+			p, err := testClient.GetPlayerOnBet(ctx, round)
+			require.NoError(t, err)
+			_, round, _ = makeAndEvaluateBet(t, ctx, round, readyGame, p, tt.bet1)
+
+			round = playToShowdown(t, ctx, round)
+			// 4 hole cards and 5 board cards
+			require.Equal(t, 18, len(round.GetWinningHand()))
+
+			board := round.GetFlop() + round.GetRiver() + round.GetTurn()
+			hole := deck.NewHand(round.GetWinningHand()[:8])
+			require.Equal(t, hole.EvaluateOmaha(deck.NewHand(board)), round.GetWinningScore())
+		})
+	}
+}
+
+func TestServer_EvaluateHandsHiLo(t *testing.T) {
+
+	tests := []struct {
+		Name      string
+		Variant   pb.GameVariant
+		Players   *pb.Players
+		Flop      string
+		River     string
+		Turn      string
+		LowScores map[int64]uint32
+	}{
+		{
+			Name:    "Holdem hi-lo with one qualifying low",
+			Variant: pb.GameVariant_HOLDEM_HI_LO,
+			Players: &pb.Players{
+				Players: []*pb.Player{
+					{Id: 1, Name: getUniqueName(), Cards: "Ah2d", InHand: true},
+					{Id: 2, Name: getUniqueName(), Cards: "KhKd", InHand: true},
+				},
+			},
+			Flop:      "3c4s9h",
+			River:     "5d",
+			Turn:      "Kc",
+			LowScores: map[int64]uint32{1: 1, 2: 0},
+		},
+		{
+			Name:    "Omaha hi-lo must use two hole cards for the low",
+			Variant: pb.GameVariant_OMAHA_HI_LO,
+			Players: &pb.Players{
+				Players: []*pb.Player{
+					{Id: 1, Name: getUniqueName(), Cards: "Ah2dKsQs", InHand: true},
+					{Id: 2, Name: getUniqueName(), Cards: "AcKhKdQd", InHand: true},
+				},
+			},
+			Flop:      "3c4s9h",
+			River:     "5d",
+			Turn:      "Kc",
+			LowScores: map[int64]uint32{1: 1, 2: 0},
+		},
+		{
+			Name:    "No low in a high only game",
+			Variant: pb.GameVariant_HOLDEM,
+			Players: &pb.Players{
+				Players: []*pb.Player{
+					{Id: 1, Name: getUniqueName(), Cards: "Ah2d", InHand: true},
+				},
+			},
+			Flop:      "3c4s9h",
+			River:     "5d",
+			Turn:      "Kc",
+			LowScores: map[int64]uint32{1: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+			r := &pb.Round{
+				Status:  pb.RoundStatus_OVER,
+				Variant: tt.Variant,
+				Players: tt.Players,
+				Flop:    tt.Flop,
+				River:   tt.River,
+				Turn:    tt.Turn,
+			}
 
+			round, err := testClient.EvaluateHands(ctx, r)
+			require.NoError(t, err)
+			for _, p := range round.GetPlayers().GetPlayers() {
+				require.Equal(t, tt.LowScores[p.GetId()], p.GetLowScore())
+			}
 		})
 	}
+}
+
+func TestServer_HiLoSettlement(t *testing.T) {
+	for _, variant := range []pb.GameVariant{pb.GameVariant_HOLDEM_HI_LO, pb.GameVariant_OMAHA_HI_LO} {
+		t.Run(variant.String(), func(t *testing.T) {
+			ctx := context.Background()
+			players := []*pb.Player{
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+			}
+			game := &pb.Game{
+				Name:    getUniqueName(),
+				Players: &pb.Players{Players: players},
+				Variant: variant,
+			}
+			round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+			round = playToShowdown(t, ctx, round)
+			require.NotZero(t, round.GetWinningPlayer())
+			if round.GetWinningLowPlayer() != 0 {
+				require.NotZero(t, round.GetWinningLowScore())
+			}
 
+			// however the pot is split no chips are lost
+			roundPlayers, err := testClient.GetRoundPlayersByRoundId(ctx, round)
+			require.NoError(t, err)
+			total := int64(0)
+			for _, p := range roundPlayers.GetPlayers() {
+				total += p.GetChips()
+			}
+			require.Equal(t, int64(3000), total)
+		})
+	}
+}
+
+func TestServer_ShortDeck(t *testing.T) {
+	ctx := context.Background()
+	players := []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}
+	game := &pb.Game{
+		Name:    getUniqueName(),
+		Players: &pb.Players{Players: players},
+		Variant: pb.GameVariant_SHORT_DECK,
+	}
+	round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+	require.Equal(t, pb.GameVariant_SHORT_DECK, round.GetVariant())
+
+	// 36 cards less a burn card and 2 cards for each of the 3 players
+	d := deck.Deck{}.Marshal(round.GetDeck())
+	require.Equal(t, deck.ShortDeckSize-7, len(d))
+
+	round = playToShowdown(t, ctx, round)
+	for _, c := range deck.NewHand(round.GetWinningHand()) {
+		require.NotContains(t, []string{"2", "3", "4", "5"}, c.Type())
+	}
+	require.Equal(t, deck.NewHand(round.GetWinningHand()).EvaluateShortDeck(), round.GetWinningScore())
+}
+
+func TestServer_Stud(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Variant  pb.GameVariant
+		Evaluate func(deck.Hand) uint32
+	}{
+		{
+			Name:     "Seven card stud",
+			Variant:  pb.GameVariant_SEVEN_CARD_STUD,
+			Evaluate: deck.Hand.EvaluateHand,
+		},
+		{
+			Name:     "Razz",
+			Variant:  pb.GameVariant_RAZZ,
+			Evaluate: deck.Hand.EvaluateRazz,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			players := []*pb.Player{
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+			}
+			game := &pb.Game{
+				Name:    getUniqueName(),
+				Players: &pb.Players{Players: players},
+				Variant: tt.Variant,
+			}
+			round, bets, readyGame := setupGame(t, &pb.Players{Players: players}, game)
+			require.Equal(t, pb.RoundStatus_THIRD_STREET, round.GetStatus())
+			require.Equal(t, pb.Bet_BRING_IN, bets.GetBets()[0].GetType())
+
+			// the bring in is forced, so it can't be bet again
+			p, err := testClient.GetPlayerOnBet(ctx, round)
+			require.NoError(t, err)
+			small := bets.GetBets()[0].GetChips()
+			for _, chips := range []int64{small, small * 100} {
+				_, err = testClient.MakeBet(ctx, &pb.Bet{
+					Player: p.GetId(),
+					Game:   readyGame.GetId(),
+					Round:  round.GetId(),
+					Chips:  chips,
+					Type:   pb.Bet_BRING_IN,
+					Status: round.GetStatus(),
+				})
+				require.EqualError(t, err, rpcError(server.ErrWrongBetType.Error()))
+			}
+
+			// 2 down cards and 1 up card each, with no burn card
+			d := deck.Deck{}.Marshal(round.GetDeck())
+			require.Equal(t, deck.FullDeckSize-9, len(d))
+			roundPlayers, err := testClient.GetRoundPlayersByRoundId(ctx, round)
+			require.NoError(t, err)
+			for _, p := range roundPlayers.GetPlayers() {
+				require.Len(t, deck.NewHand(p.GetCards()), 3)
+				require.Len(t, deck.NewHand(p.GetUpCards()), 1)
+				require.Equal(t, p.GetCards()[4:], p.GetUpCards())
+			}
+
+			round = playToShowdown(t, ctx, round)
+			require.Equal(t, "", round.GetFlop())
+			require.Len(t, deck.NewHand(round.GetWinningHand()), 7)
+			require.Equal(t, tt.Evaluate(deck.NewHand(round.GetWinningHand())), round.GetWinningScore())
+
+			roundPlayers, err = testClient.GetRoundPlayersByRoundId(ctx, round)
+			require.NoError(t, err)
+			chips := int64(0)
+			for _, p := range roundPlayers.GetPlayers() {
+				// fourth through sixth street are dealt face up
+				require.Len(t, deck.NewHand(p.GetUpCards()), 4)
+				chips += p.GetChips()
+			}
+			require.Equal(t, int64(3000), chips)
+
+			v, err := testClient.VerifyRound(ctx, round)
+			require.NoError(t, err)
+			require.True(t, v.GetValid())
+		})
+	}
+}
+
+func TestServer_CommittedServerSeed(t *testing.T) {
+	ctx := context.Background()
+	// the server's seeds are drawn from a seeded shuffler, so the first round's seed is known
+	defer func(c pb.PokerClient) { testClient = c }(testClient)
+	testClient = seededTestClient(t, 7)
+	serverSeed := deck.NewServerSeed(deck.NewSeededShuffler(7))
+
+	players := []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}
+	_, err := testClient.CreatePlayers(ctx, &pb.Players{Players: players})
+	require.NoError(t, err)
+	game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+	require.NoError(t, err)
+	game.Players = &pb.Players{Players: players}
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.GetGame(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.AllocateGameSlots(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.SetButtonPositions(ctx, game)
+	require.NoError(t, err)
+	game.Min = minChips
+	game, err = testClient.SetMin(ctx, game)
+	require.NoError(t, err)
+
+	// the commitment is published with the round, before the client seed is supplied
+	round, err := testClient.CreateRoundFromGame(ctx, game)
+	require.NoError(t, err)
+	require.Equal(t, deck.Commitment(serverSeed), round.GetCommitment())
+	require.Empty(t, round.GetServerSeed())
+
+	round, err = testClient.ValidatePreRound(ctx, round)
+	require.NoError(t, err)
+	round.ClientSeed = "client"
+	round, err = testClient.StartRound(ctx, round)
+	require.NoError(t, err)
+	require.Equal(t, deck.Commitment(serverSeed), round.GetCommitment())
+	require.Equal(t, "client", round.GetClientSeed())
+
+	// the cards are dealt off the end of the deck shuffled from both seeds
+	shuffled := deck.FairShuffle(deck.New(), serverSeed, "client").String()
+	require.True(t, strings.HasPrefix(shuffled, round.GetDeck()))
+	for _, p := range round.GetPlayers().GetPlayers() {
+		require.Len(t, p.GetCards(), 4)
+		require.Contains(t, shuffled[len(round.GetDeck()):], p.GetCards()[:2])
+	}
+
+	round = playToShowdown(t, ctx, round)
+	require.Equal(t, serverSeed, round.GetServerSeed())
+	v, err := testClient.VerifyRound(ctx, round)
+	require.NoError(t, err)
+	require.True(t, v.GetValid())
+	require.Equal(t, shuffled, v.GetDeck())
+}
+
+func TestServer_VerifyRound(t *testing.T) {
+	ctx := context.Background()
+	players := []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}
+	game := &pb.Game{
+		Name:    getUniqueName(),
+		Players: &pb.Players{Players: players},
+	}
+	round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+
+	// commitment is published but the seed is secret until the round is over
+	require.Len(t, round.GetCommitment(), 64)
+	require.Equal(t, "", round.GetServerSeed())
+	_, err := testClient.VerifyRound(ctx, round)
+	require.EqualError(t, err, rpcError(server.ErrRoundNotOver.Error()))
+
+	round = playToShowdown(t, ctx, round)
+	require.Len(t, round.GetServerSeed(), 64)
+	// the winning hand is described once the round is over
+	require.Equal(t, pb.HandCategory(deck.Category(round.GetWinningScore())), round.GetWinningCategory())
+	require.NotEmpty(t, round.GetWinningHandName())
+	require.Len(t, round.GetWinningBestHand(), 10)
+
+	v, err := testClient.VerifyRound(ctx, round)
+	require.NoError(t, err)
+	require.True(t, v.GetValid())
+	require.Equal(t, round.GetCommitment(), v.GetCommitment())
+	require.Equal(t, round.GetServerSeed(), v.GetServerSeed())
+
+	d, valid := deck.Verify(deck.New(), round.GetServerSeed(), round.GetClientSeed(), round.GetCommitment())
+	require.True(t, valid)
+	require.Equal(t, d.String(), v.GetDeck())
+
+	// every card dealt came from the committed deck
+	roundPlayers, err := testClient.GetRoundPlayersByRoundId(ctx, round)
+	require.NoError(t, err)
+	dealt := v.GetDeck()[len(round.GetDeck()):]
+	cards := round.GetFlop() + round.GetRiver() + round.GetTurn()
+	for _, p := range roundPlayers.GetPlayers() {
+		cards += p.GetCards()
+	}
+	for _, c := range deck.NewHand(cards) {
+		require.Contains(t, dealt, c.String())
+	}
+
+	// a dealt card that didn't come off the deck where it was dealt fails verification
+	tampered := *round
+	tampered.Flop = round.GetDeck()[len(round.GetDeck())-2:] + round.GetFlop()[2:]
+	_, err = testClient.UpdateRoundFlop(ctx, &tampered)
+	require.NoError(t, err)
+	v, err = testClient.VerifyRound(ctx, round)
+	require.NoError(t, err)
+	require.False(t, v.GetValid())
+}
+
+func TestServer_ShowdownHands(t *testing.T) {
+	ctx := context.Background()
+	players := []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}
+	game := &pb.Game{Name: getUniqueName(), Players: &pb.Players{Players: players}}
+	round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+
+	// call the last street down through MakeBet so the settled round it returns is checked
+	round = playToStatus(t, ctx, round, pb.RoundStatus_TURN)
+	require.Equal(t, pb.RoundStatus_TURN, round.GetStatus())
+	for round.GetStatus() != pb.RoundStatus_OVER {
+		current, err := testClient.GetRound(ctx, round)
+		require.NoError(t, err)
+		p, err := testClient.GetPlayerOnBet(ctx, current)
+		require.NoError(t, err)
+		round, err = testClient.MakeBet(ctx, &pb.Bet{
+			Player: p.GetId(),
+			Game:   round.GetGame(),
+			Round:  round.GetId(),
+			Type:   pb.Bet_CALL,
+			Status: round.GetStatus(),
+		})
+		require.NoError(t, err)
+	}
+
+	stored, err := testClient.GetRound(ctx, round)
+	require.NoError(t, err)
+	for _, r := range []*pb.Round{round, stored} {
+		require.Len(t, r.GetPlayers().GetPlayers(), 2)
+		for _, p := range r.GetPlayers().GetPlayers() {
+			require.NotEqual(t, pb.HandCategory_NO_HAND, p.GetHandCategory())
+			require.NotEmpty(t, p.GetHandName())
+			require.Len(t, p.GetBestHand(), 10)
+			if p.GetId() == r.GetWinningPlayer() {
+				require.Equal(t, r.GetWinningCategory(), p.GetHandCategory())
+				require.Equal(t, r.GetWinningHandName(), p.GetHandName())
+				require.Equal(t, r.GetWinningBestHand(), p.GetBestHand())
+			}
+		}
+	}
+}
+
+func TestServer_UpdateRoundCardsInvalid(t *testing.T) {
+	ctx := context.Background()
+	players := []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}
+	round, _, _ := setupGame(t, &pb.Players{Players: players}, &pb.Game{Name: getUniqueName(), Players: &pb.Players{Players: players}})
+
+	// malformed cards are never saved
+	_, err := testClient.UpdateDeck(ctx, &pb.Round{Id: round.GetId(), Deck: round.GetDeck() + "Xx"})
+	require.Contains(t, err.Error(), deck.ErrInvalidCard.Error())
+	_, err = testClient.UpdateRoundFlop(ctx, &pb.Round{Id: round.GetId(), Flop: "AsKdQ"})
+	require.Contains(t, err.Error(), deck.ErrInvalidCard.Error())
+	_, err = testClient.UpdateRoundRiver(ctx, &pb.Round{Id: round.GetId(), River: "1s"})
+	require.Contains(t, err.Error(), deck.ErrInvalidCard.Error())
+	_, err = testClient.UpdateRoundTurn(ctx, &pb.Round{Id: round.GetId(), Turn: "Ax"})
+	require.Contains(t, err.Error(), deck.ErrInvalidCard.Error())
+
+	stored, err := testClient.GetRound(ctx, round)
+	require.NoError(t, err)
+	require.Equal(t, round.GetDeck(), stored.GetDeck())
+	require.Empty(t, stored.GetFlop()+stored.GetRiver()+stored.GetTurn())
+}
+
+func TestServer_UpdatePlayersCardsInvalid(t *testing.T) {
+	ctx := context.Background()
+	players, err := testClient.CreatePlayers(ctx, &pb.Players{Players: []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}})
+	require.NoError(t, err)
+
+	p := players.GetPlayers()[0]
+	p.Cards = "AsXx"
+	_, err = testClient.UpdatePlayersCards(ctx, players)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), deck.ErrInvalidCard.Error())
+
+	p.Cards = "AsKd"
+	players, err = testClient.UpdatePlayersCards(ctx, players)
+	require.NoError(t, err)
+	require.Equal(t, "AsKd", players.GetPlayers()[0].GetCards())
+}
+
+func TestServer_CalculateEquity(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		Name      string
+		Request   *pb.EquityRequest
+		ExpEquity []float64
+		ExpError  string
+	}{
+		{
+			Name: "Flush draw on the turn",
+			Request: &pb.EquityRequest{
+				Hands: []string{"AhKh", "QsQd"},
+				Board: "2h7h9cTs",
+			},
+			ExpEquity: []float64{100 * 15.0 / 44, 100 * 29.0 / 44},
+		},
+		{
+			Name: "Malformed hand",
+			Request: &pb.EquityRequest{
+				Hands: []string{"AhKx", "QsQd"},
+			},
+			ExpError: "invalid card",
+		},
+		{
+			Name: "Duplicate card",
+			Request: &pb.EquityRequest{
+				Hands: []string{"AhKh", "QsQd"},
+				Board: "Qs2c3c",
+			},
+			ExpError: rpcError(deck.ErrDuplicateCard.Error()),
+		},
+		{
+			Name: "Too many iterations",
+			Request: &pb.EquityRequest{
+				Hands:      []string{"AhKh", "QsQd"},
+				Iterations: server.MaxEquityIterations + 1,
+			},
+			ExpError: rpcError(server.ErrTooManyIterations.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			out, err := testClient.CalculateEquity(ctx, tt.Request)
+			if tt.ExpError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.ExpError)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, out.GetExhaustive())
+			for i, h := range out.GetHands() {
+				require.Equal(t, tt.Request.GetHands()[i], h.GetHand())
+				require.InDelta(t, tt.ExpEquity[i], h.GetEquity(), 1e-9)
+			}
+		})
+	}
+}
+
+func TestServer_AllInRunOut(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Runs     []int32
+		ExpRuns  int
+		ExpError string
+	}{
+		{
+			Name:    "Run it once",
+			ExpRuns: 1,
+		},
+		{
+			Name:    "Run it twice",
+			Runs:    []int32{2, 2},
+			ExpRuns: 2,
+		},
+		{
+			Name:    "Run the fewest times agreed",
+			Runs:    []int32{3, 2},
+			ExpRuns: 2,
+		},
+		{
+			Name:    "Run once when a player doesn't agree",
+			Runs:    []int32{2},
+			ExpRuns: 1,
+		},
+		{
+			Name:     "Too many runs",
+			Runs:     []int32{server.MaxRuns + 1},
+			ExpError: rpcError(server.ErrInvalidRuns.Error()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			// blinds of 10/20 put both players all in preflop once the small blind calls
+			players := []*pb.Player{
+				{
+					Name:  getUniqueName(),
+					Chips: 20,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 20,
+				},
+			}
+			game := &pb.Game{
+				Name:    getUniqueName(),
+				Players: &pb.Players{Players: players},
+			}
+			round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+
+			for i, runs := range tt.Runs {
+				_, err := testClient.AgreeToRuns(ctx, &pb.RunAgreement{
+					Round:  round.GetId(),
+					Player: round.GetPlayers().GetPlayers()[i].GetId(),
+					Runs:   runs,
+				})
+				if tt.ExpError != "" {
+					require.Equal(t, tt.ExpError, err.Error())
+					return
+				}
+				require.NoError(t, err)
+			}
+
+			round = playToShowdown(t, ctx, round)
+			require.True(t, round.GetAllIn())
+			require.NotZero(t, round.GetWinningPlayer())
+			require.Equal(t, 10, len(round.GetFlop()+round.GetRiver()+round.GetTurn()))
+
+			if tt.ExpRuns > 1 {
+				require.Equal(t, tt.ExpRuns, len(round.GetRunBoards()))
+				require.Equal(t, round.GetFlop()+round.GetRiver()+round.GetTurn(), round.GetRunBoards()[0])
+				for _, b := range round.GetRunBoards()[1:] {
+					require.Equal(t, 10, len(b))
+					require.NotEqual(t, round.GetRunBoards()[0], b)
+				}
+			} else {
+				require.Empty(t, round.GetRunBoards())
+			}
+			v, err := testClient.VerifyRound(ctx, round)
+			require.NoError(t, err)
+			require.True(t, v.GetValid())
+
+			// equity is recorded preflop, then on the flop, 4th and 5th card of each run
+			require.Equal(t, 1+3*tt.ExpRuns, len(round.GetEquities()))
+			for _, e := range round.GetEquities() {
+				total := 0.0
+				for _, h := range e.GetHands() {
+					require.NotZero(t, h.GetPlayer())
+					total += h.GetEquity()
+				}
+				require.InDelta(t, 100, total, 1e-9)
+			}
+			final := round.GetEquities()[3]
+			require.Equal(t, pb.RoundStatus_TURN, final.GetStatus())
+			require.Equal(t, round.GetFlop()+round.GetRiver()+round.GetTurn(), final.GetBoard())
+
+			// however the pot is split between the runs no chips are lost
+			roundPlayers, err := testClient.GetRoundPlayersByRoundId(ctx, round)
+			require.NoError(t, err)
+			total := int64(0)
+			for _, p := range roundPlayers.GetPlayers() {
+				total += p.GetChips()
+			}
+			require.Equal(t, int64(40), total)
+		})
+	}
+}
+
+func TestServer_CalculateRangeEquity(t *testing.T) {
+	ctx := context.Background()
+
+	out, err := testClient.CalculateRangeEquity(ctx, &pb.RangeEquityRequest{
+		Ranges: []string{"99", "TT"},
+		Board:  "2h7h9cTs",
+	})
+	require.NoError(t, err)
+	require.True(t, out.GetExhaustive())
+	require.Equal(t, int64(3*3*44), out.GetBoards())
+	require.Equal(t, "99", out.GetHands()[0].GetHand())
+	require.InDelta(t, 100, out.GetHands()[0].GetEquity()+out.GetHands()[1].GetEquity(), 1e-9)
+
+	_, err = testClient.CalculateRangeEquity(ctx, &pb.RangeEquityRequest{
+		Ranges: []string{"QQ+", "AKx"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), deck.ErrInvalidRange.Error())
+
+	_, err = testClient.CalculateRangeEquity(ctx, &pb.RangeEquityRequest{
+		Ranges:     []string{"99", "TT"},
+		Iterations: server.MaxEquityIterations + 1,
+	})
+	require.EqualError(t, err, rpcError(server.ErrTooManyIterations.Error()))
+}
+
+func TestServer_TrainingOuts(t *testing.T) {
+	for _, training := range []bool{true, false} {
+		t.Run(fmt.Sprintf("Training %v", training), func(t *testing.T) {
+			ctx := context.Background()
+			players := []*pb.Player{
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+			}
+			game := &pb.Game{
+				Name:     getUniqueName(),
+				Players:  &pb.Players{Players: players},
+				Training: training,
+			}
+			round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+			require.Equal(t, training, round.GetTraining())
+			// no outs before the flop
+			require.Empty(t, round.GetOuts())
+
+			for _, status := range []pb.RoundStatus{pb.RoundStatus_FLOP, pb.RoundStatus_RIVER} {
+				round = playToStatus(t, ctx, round, status)
+				require.Equal(t, status, round.GetStatus())
+				if !training {
+					require.Empty(t, round.GetOuts())
+					continue
+				}
+
+				require.Equal(t, 2, len(round.GetOuts()))
+				leading := 0
+				for _, o := range round.GetOuts() {
+					require.NotZero(t, o.GetPlayer())
+					if o.GetLeading() {
+						leading++
+						require.Empty(t, o.GetOuts())
+						require.Zero(t, o.GetProbability())
+					} else {
+						require.Equal(t, o.GetOuts() != "", o.GetProbability() > 0)
+					}
+				}
+				require.NotZero(t, leading)
+			}
+
+			round = playToShowdown(t, ctx, round)
+			require.Empty(t, round.GetOuts())
+		})
+	}
+}
+
+func TestServer_ExportHandHistory(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Variant  pb.GameVariant
+		ExpLines []string
+	}{
+		{
+			Name:    "Hold'em",
+			Variant: pb.GameVariant_HOLDEM,
+			ExpLines: []string{
+				"8-max Seat #",
+				": posts small blind 10",
+				": posts big blind 20",
+				"*** HOLE CARDS ***",
+				"*** FLOP *** [",
+				"*** TURN *** [",
+				"*** RIVER *** [",
+				"Board [",
+				" folded before Flop (didn't bet)",
+			},
+		},
+		{
+			Name:    "Stud",
+			Variant: pb.GameVariant_SEVEN_CARD_STUD,
+			ExpLines: []string{
+				": brings in for",
+				"*** 3rd STREET ***",
+				"*** 4th STREET ***",
+				"*** RIVER ***",
+				" folded on 3rd St.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			players := []*pb.Player{
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+				{
+					Name:  getUniqueName(),
+					Chips: 1000,
+				},
+			}
+			game := &pb.Game{
+				Name:       getUniqueName(),
+				Players:    &pb.Players{Players: players},
+				Variant:    tt.Variant,
+				SmallBlind: 10,
+				BigBlind:   20,
+			}
+			round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+
+			_, err := testClient.ExportHandHistory(ctx, &pb.HandHistoryRequest{Round: round.GetId()})
+			require.EqualError(t, err, rpcError(server.ErrRoundNotOver.Error()))
+
+			// the first player to act folds and the others check it down
+			p, err := testClient.GetPlayerOnBet(ctx, round)
+			require.NoError(t, err)
+			_, err = testClient.MakeBet(ctx, &pb.Bet{
+				Player: p.GetId(),
+				Game:   round.GetGame(),
+				Round:  round.GetId(),
+				Type:   pb.Bet_FOLD,
+				Status: round.GetStatus(),
+			})
+			require.NoError(t, err)
+			round = playToShowdown(t, ctx, round)
+
+			history, err := testClient.ExportHandHistory(ctx, &pb.HandHistoryRequest{Round: round.GetId()})
+			require.NoError(t, err)
+			require.Equal(t, []int64{round.GetId()}, history.GetRounds())
+			text := history.GetText()
+			require.True(t, strings.HasPrefix(text, fmt.Sprintf("PokerStars Hand #%d: ", round.GetId())))
+			require.Contains(t, text, fmt.Sprintf("Table '%s' ", game.GetName()))
+			for _, line := range tt.ExpLines {
+				require.Contains(t, text, line)
+			}
+			// starting stacks are from before the blinds or bring in
+			for _, p := range players {
+				require.Contains(t, text, fmt.Sprintf(": %s (1000 in chips)", p.GetName()))
+			}
+			require.Contains(t, text, fmt.Sprintf("%s: folds", p.GetName()))
+			require.Contains(t, text, "*** SHOW DOWN ***")
+			require.Contains(t, text, "*** SUMMARY ***")
+
+			// what's collected is the pot less the rake
+			var pot, rake, collected int64
+			_, err = fmt.Sscanf(text[strings.Index(text, "Total pot"):], "Total pot %d | Rake %d", &pot, &rake)
+			require.NoError(t, err)
+			for _, line := range strings.Split(text, "\n") {
+				var won int64
+				if i := strings.Index(line, " collected "); i >= 0 {
+					_, err := fmt.Sscanf(line[i:], " collected %d from pot", &won)
+					require.NoError(t, err)
+					collected += won
+				}
+			}
+			require.NotZero(t, pot)
+			require.Equal(t, pot-rake, collected)
+
+			// exporting the game has the same hand
+			all, err := testClient.ExportHandHistory(ctx, &pb.HandHistoryRequest{Game: round.GetGame()})
+			require.NoError(t, err)
+			require.Equal(t, history.GetRounds(), all.GetRounds())
+			require.Equal(t, text, all.GetText())
+		})
+	}
+}
+
+// replayHand collects a round's replayed event log
+func replayHand(ctx context.Context, in *pb.ReplayRequest) ([]*pb.HandEvent, error) {
+	stream, err := testClient.ReplayHand(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	out := []*pb.HandEvent{}
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			return out, nil
+		} else if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+}
+
+func TestServer_ReplayHand(t *testing.T) {
+	ctx := context.Background()
+	players := []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}
+	game := &pb.Game{
+		Name:       getUniqueName(),
+		Players:    &pb.Players{Players: players},
+		SmallBlind: 10,
+		BigBlind:   20,
+	}
+	round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+
+	_, err := replayHand(ctx, &pb.ReplayRequest{Round: round.GetId()})
+	require.EqualError(t, err, rpcError(server.ErrRoundNotOver.Error()))
+
+	round = playToShowdown(t, ctx, round)
+	events, err := replayHand(ctx, &pb.ReplayRequest{Round: round.GetId()})
+	require.NoError(t, err)
+
+	start := events[0]
+	require.Equal(t, pb.HandEventType_START, start.GetType())
+	require.Equal(t, pb.RoundStatus_PRE_FLOP, start.GetStatus())
+	require.Equal(t, round.GetDealer(), start.GetSlot())
+	// a card is burnt before the hole cards
+	require.Len(t, deck.NewHand(start.GetDeck()), deck.FullDeckSize-7)
+
+	// rebuild the stacks and board from the log
+	stacks := map[int64]int64{}
+	board := ""
+	streets := []pb.RoundStatus{}
+	var bet, won int64
+	for i, e := range events {
+		require.Equal(t, int64(i+1), e.GetSeq())
+		require.Equal(t, round.GetId(), e.GetRound())
+		switch e.GetType() {
+		case pb.HandEventType_SEAT:
+			require.Equal(t, int64(1000), e.GetChips())
+			stacks[e.GetPlayer()] = e.GetChips()
+		case pb.HandEventType_DEAL:
+			if e.GetPlayer() == 0 {
+				board += e.GetCards()
+			} else {
+				require.Len(t, deck.NewHand(e.GetCards()), 2)
+			}
+		case pb.HandEventType_BET:
+			stacks[e.GetPlayer()] -= e.GetChips()
+			bet += e.GetChips()
+		case pb.HandEventType_STREET:
+			streets = append(streets, e.GetStatus())
+		case pb.HandEventType_SETTLE:
+			stacks[e.GetPlayer()] += e.GetChips()
+			won += e.GetChips()
+		}
+	}
+	require.Len(t, stacks, 3)
+	require.Equal(t, int64(60), bet)
+	require.Equal(t, bet, won)
+	require.Equal(t, round.GetFlop()+round.GetRiver()+round.GetTurn(), board)
+	require.Equal(t, []pb.RoundStatus{
+		pb.RoundStatus_FLOP,
+		pb.RoundStatus_RIVER,
+		pb.RoundStatus_TURN,
+		pb.RoundStatus_SHOW,
+		pb.RoundStatus_OVER,
+	}, streets)
+	require.Equal(t, pb.HandEventType_SETTLE, events[len(events)-1].GetType())
+
+	for _, p := range round.GetPlayers().GetPlayers() {
+		player, err := testClient.GetPlayer(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, player.GetChips(), stacks[p.GetId()])
+	}
+
+	// paced replays stream the same events
+	paced, err := replayHand(ctx, &pb.ReplayRequest{Round: round.GetId(), Speed: 100})
+	require.NoError(t, err)
+	require.Equal(t, len(events), len(paced))
+}
+
+func TestServer_SidePots(t *testing.T) {
+	tests := []struct {
+		Name string
+		Seed int64
+		// the big stacks go all in on the flop and run it twice, instead of betting on the next street
+		RunTwice bool
+		// the short stack checks the flop then calls a bet all in for less, instead of going all in
+		ShortCall bool
+		// chips left in seat order, the short stack is in seat 2
+		ExpChips []int64
+	}{
+		{
+			Name:     "Short stack wins the main pot",
+			Seed:     4,
+			ExpChips: []int64{60, 120, 260},
+		},
+		{
+			Name:     "Short stack loses the main pot",
+			Seed:     1,
+			ExpChips: []int64{60, 0, 380},
+		},
+		{
+			Name:      "Short stack calls all in for less",
+			Seed:      4,
+			ShortCall: true,
+			ExpChips:  []int64{80, 120, 240},
+		},
+		{
+			// the short stack wins the main pot on one board, the side pot can only go to the big stacks
+			Name:     "Each run settles each pot",
+			Seed:     4,
+			RunTwice: true,
+			ExpChips: []int64{0, 60, 380},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			players := []*pb.Player{
+				{Name: getUniqueName(), Chips: 200},
+				{Name: getUniqueName(), Chips: 40},
+				{Name: getUniqueName(), Chips: 200},
+			}
+			game := &pb.Game{
+				Name:       getUniqueName(),
+				Players:    &pb.Players{Players: players},
+				SmallBlind: 10,
+				BigBlind:   20,
+			}
+			defer func(c pb.PokerClient) { testClient = c }(testClient)
+			testClient = seededTestClient(t, tt.Seed)
+			round, _, readyGame := setupGameWithDealer(t, &pb.Players{Players: players}, game, 1)
+			seats := map[int64]*pb.Player{}
+			for _, p := range readyGame.GetPlayers().GetPlayers() {
+				seats[p.GetSlot()] = p
+			}
+			if tt.RunTwice {
+				for _, p := range seats {
+					_, err := testClient.AgreeToRuns(ctx, &pb.RunAgreement{Round: round.GetId(), Player: p.GetId(), Runs: 2})
+					require.NoError(t, err)
+				}
+			}
+
+			// bet makes the player in the seat call the amount to call plus the extra chips
+			bet := func(seat int64, betType pb.Bet_BetType, extra int64) {
+				round, err := testClient.GetRound(ctx, &pb.Round{Id: round.GetId()})
+				require.NoError(t, err)
+				require.Equal(t, seat, round.GetAction())
+				p := seats[seat]
+				amt, err := testClient.GetAmountToCallForPlayer(ctx, &pb.AmountToCall{Player: p, Round: round})
+				require.NoError(t, err)
+				_, err = testClient.MakeBet(ctx, &pb.Bet{
+					Player: p.GetId(),
+					Game:   round.GetGame(),
+					Round:  round.GetId(),
+					Chips:  amt.GetChips() + extra,
+					Type:   betType,
+					Status: round.GetStatus(),
+				})
+				require.NoError(t, err)
+			}
+
+			// everyone limps, then the short stack goes all in on the flop
+			bet(1, pb.Bet_CALL, 0)
+			bet(2, pb.Bet_CALL, 0)
+			switch {
+			case tt.ShortCall:
+				bet(2, pb.Bet_CALL, 0)
+				bet(3, pb.Bet_RAISE, 100)
+				bet(1, pb.Bet_CALL, 0)
+				// the call is the short stack's last 20 chips, short of the 100 to call
+				bet(2, pb.Bet_CALL, -80)
+			case tt.RunTwice:
+				bet(2, pb.Bet_RAISE, 20)
+				bet(3, pb.Bet_RAISE, 160)
+				bet(1, pb.Bet_CALL, 0)
+			default:
+				bet(2, pb.Bet_RAISE, 20)
+				bet(3, pb.Bet_CALL, 0)
+				bet(1, pb.Bet_CALL, 0)
+				// the short stack has no chips left so isn't put on action
+				bet(3, pb.Bet_RAISE, 100)
+				bet(1, pb.Bet_CALL, 0)
+			}
+			round = playToShowdown(t, ctx, round)
+			require.Equal(t, pb.RoundStatus_OVER, round.GetStatus())
+			require.Equal(t, tt.RunTwice, round.GetAllIn())
+			if tt.RunTwice {
+				require.Len(t, round.GetRunBoards(), 2)
+			}
+
+			chips := []int64{}
+			for seat := int64(1); seat <= 3; seat++ {
+				p, err := testClient.GetPlayer(ctx, seats[seat])
+				require.NoError(t, err)
+				chips = append(chips, p.GetChips())
+			}
+			require.Equal(t, tt.ExpChips, chips)
+		})
+	}
+}
+
+func TestServer_GetPlayerStats(t *testing.T) {
+	ctx := context.Background()
+	players := []*pb.Player{
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+		{
+			Name:  getUniqueName(),
+			Chips: 1000,
+		},
+	}
+	game := &pb.Game{
+		Name:       getUniqueName(),
+		Players:    &pb.Players{Players: players},
+		SmallBlind: 10,
+		BigBlind:   20,
+	}
+	// the hand is dealt on a seeded server so the raiser wins it outright
+	defer func(c pb.PokerClient) { testClient = c }(testClient)
+	testClient = seededTestClient(t, 3)
+	start := time.Now().UnixNano() / 1e6
+	round, _, readyGame := setupGameWithDealer(t, &pb.Players{Players: players}, game, 1)
+
+	// bet makes the player on action call the amount to call plus the extra chips
+	bet := func(betType pb.Bet_BetType, extra int64) *pb.Player {
+		round, err := testClient.GetRound(ctx, &pb.Round{Id: round.GetId()})
+		require.NoError(t, err)
+		p, err := testClient.GetPlayerOnBet(ctx, round)
+		require.NoError(t, err)
+		amt, err := testClient.GetAmountToCallForPlayer(ctx, &pb.AmountToCall{Player: p, Round: round})
+		require.NoError(t, err)
+		chips := amt.GetChips() + extra
+		if betType == pb.Bet_FOLD {
+			chips = 0
+		}
+		_, err = testClient.MakeBet(ctx, &pb.Bet{
+			Player: p.GetId(),
+			Game:   round.GetGame(),
+			Round:  round.GetId(),
+			Chips:  chips,
+			Type:   betType,
+			Status: round.GetStatus(),
+		})
+		require.NoError(t, err)
+		return p
+	}
+
+	// the button folds, the small blind raises and the big blind calls,
+	// then the small blind bets the flop and is called before it's checked down
+	folder := bet(pb.Bet_FOLD, 0)
+	raiser := bet(pb.Bet_RAISE, 20)
+	caller := bet(pb.Bet_CALL, 0)
+	round, err := testClient.GetRound(ctx, &pb.Round{Id: round.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, pb.RoundStatus_FLOP, round.GetStatus())
+	require.Equal(t, raiser.GetId(), bet(pb.Bet_RAISE, 20).GetId())
+	require.Equal(t, caller.GetId(), bet(pb.Bet_CALL, 0).GetId())
+	round = playToShowdown(t, ctx, round)
+	require.Equal(t, pb.RoundStatus_OVER, round.GetStatus())
+
+	stats := map[int64]*pb.PlayerStats{}
+	for _, p := range []*pb.Player{folder, raiser, caller} {
+		s, err := testClient.GetPlayerStats(ctx, &pb.PlayerStatsRequest{Player: p.GetId()})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), s.GetHands())
+		stats[p.GetId()] = s
+	}
+
+	f, r, c := stats[folder.GetId()], stats[raiser.GetId()], stats[caller.GetId()]
+	assert.Equal(t, float64(0), f.GetVpip())
+	assert.Equal(t, float64(0), f.GetWentToShowdown())
+	assert.Equal(t, int64(0), f.GetNet())
+
+	assert.Equal(t, float64(100), r.GetVpip())
+	assert.Equal(t, float64(100), r.GetPfr())
+	assert.Equal(t, float64(0), r.GetThreeBet())
+	assert.Equal(t, float64(1), r.GetAggressionFactor())
+	assert.Equal(t, float64(100), r.GetWentToShowdown())
+
+	assert.Equal(t, float64(100), c.GetVpip())
+	assert.Equal(t, float64(0), c.GetPfr())
+	assert.Equal(t, float64(0), c.GetThreeBet())
+	assert.Equal(t, float64(0), c.GetAggressionFactor())
+	assert.Equal(t, float64(100), c.GetWentToShowdown())
+
+	// there's no rake, so what the raiser won the caller lost
+	assert.Equal(t, raiser.GetId(), round.GetWinningPlayer())
+	assert.Equal(t, float64(100), r.GetWonAtShowdown())
+	assert.Equal(t, int64(60), r.GetNet())
+	assert.Equal(t, float64(0), c.GetWonAtShowdown())
+	assert.Equal(t, int64(-60), c.GetNet())
+
+	filters := []struct {
+		Name     string
+		Request  *pb.PlayerStatsRequest
+		ExpHands int64
+		ExpError string
+	}{
+		{
+			Name:     "Game",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), Game: readyGame.GetId()},
+			ExpHands: 1,
+		},
+		{
+			Name:     "Other game",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), Game: readyGame.GetId() + 1},
+			ExpHands: 0,
+		},
+		{
+			Name:     "Stakes",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), BigBlind: 20},
+			ExpHands: 1,
+		},
+		{
+			Name:     "Other stakes",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), BigBlind: 40},
+			ExpHands: 0,
+		},
+		{
+			Name:     "Date range",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), From: start, To: time.Now().Add(time.Minute).UnixNano() / 1e6},
+			ExpHands: 1,
+		},
+		{
+			Name:     "Before the hand",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), To: start},
+			ExpHands: 0,
+		},
+		{
+			Name:     "Invalid date range",
+			Request:  &pb.PlayerStatsRequest{Player: raiser.GetId(), From: start, To: start},
+			ExpError: rpcError(server.ErrInvalidDateRange.Error()),
+		},
+		{
+			Name:     "Unknown player",
+			Request:  &pb.PlayerStatsRequest{Player: -1},
+			ExpError: rpcError(server.ErrPlayerDoesntExist.Error()),
+		},
+	}
+	for _, tt := range filters {
+		t.Run(tt.Name, func(t *testing.T) {
+			s, err := testClient.GetPlayerStats(ctx, tt.Request)
+			if tt.ExpError != "" {
+				require.EqualError(t, err, tt.ExpError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.ExpHands, s.GetHands())
+		})
+	}
+}
+
+func TestServer_GetLeaderboard(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UnixNano() / 1e6
+	season, err := testClient.CreateSeason(ctx, &pb.Season{Name: getUniqueName(), Start: now, End: now + time.Hour.Milliseconds()})
+	require.NoError(t, err)
+	past, err := testClient.CreateSeason(ctx, &pb.Season{Name: getUniqueName(), Start: now - 2*time.Hour.Milliseconds(), End: now - time.Hour.Milliseconds()})
+	require.NoError(t, err)
+
+	// a heads up hold'em hand and a 3 handed omaha hand are checked down
+	for _, v := range []struct {
+		Variant pb.GameVariant
+		Players int
+	}{{pb.GameVariant_HOLDEM, 2}, {pb.GameVariant_OMAHA, 3}} {
+		players := []*pb.Player{}
+		for i := 0; i < v.Players; i++ {
+			players = append(players, &pb.Player{Name: getUniqueName(), Chips: 1000})
+		}
+		game := &pb.Game{
+			Name:    getUniqueName(),
+			Players: &pb.Players{Players: players},
+			Variant: v.Variant,
+		}
+		round, _, _ := setupGame(t, &pb.Players{Players: players}, game)
+		round = playToShowdown(t, ctx, round)
+		require.Equal(t, pb.RoundStatus_OVER, round.GetStatus())
+	}
+	// a season created after the hands were settled is caught up with them
+	late, err := testClient.CreateSeason(ctx, &pb.Season{Name: getUniqueName(), Start: now, End: now + time.Hour.Milliseconds()})
+	require.NoError(t, err)
+
+	tests := []struct {
+		Name         string
+		Request      *pb.LeaderboardRequest
+		ExpTotal     int64
+		ExpStandings int
+		ExpError     string
+	}{
+		{
+			Name:         "Every variant",
+			Request:      &pb.LeaderboardRequest{Season: season.GetId()},
+			ExpTotal:     5,
+			ExpStandings: 5,
+		},
+		{
+			Name:         "Hold'em",
+			Request:      &pb.LeaderboardRequest{Season: season.GetId(), ByVariant: true, Variant: pb.GameVariant_HOLDEM},
+			ExpTotal:     2,
+			ExpStandings: 2,
+		},
+		{
+			Name:         "Omaha by points",
+			Request:      &pb.LeaderboardRequest{Season: season.GetId(), ByVariant: true, Variant: pb.GameVariant_OMAHA, Ranking: pb.LeaderboardRanking_POINTS},
+			ExpTotal:     3,
+			ExpStandings: 3,
+		},
+		{
+			Name:         "Last page",
+			Request:      &pb.LeaderboardRequest{Season: season.GetId(), Page: 2, PageSize: 2},
+			ExpTotal:     5,
+			ExpStandings: 1,
+		},
+		{
+			Name:         "Season created after the hands",
+			Request:      &pb.LeaderboardRequest{Season: late.GetId()},
+			ExpTotal:     5,
+			ExpStandings: 5,
+		},
+		{
+			Name:     "Season ended before the hands",
+			Request:  &pb.LeaderboardRequest{Season: past.GetId()},
+			ExpTotal: 0,
+		},
+		{
+			Name:     "Unknown season",
+			Request:  &pb.LeaderboardRequest{Season: -1},
+			ExpError: rpcError(server.ErrSeasonDoesntExist.Error()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			board, err := testClient.GetLeaderboard(ctx, tt.Request)
+			if tt.ExpError != "" {
+				require.EqualError(t, err, tt.ExpError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.ExpTotal, board.GetTotal())
+			require.Equal(t, tt.ExpStandings, len(board.GetStandings()))
+
+			size := int64(tt.Request.GetPageSize())
+			if size == 0 {
+				size = int64(len(board.GetStandings()))
+			}
+			var net int64
+			for i, st := range board.GetStandings() {
+				require.Equal(t, int64(tt.Request.GetPage())*size+int64(i)+1, st.GetRank())
+				require.NotEmpty(t, st.GetName())
+				require.Equal(t, int64(1), st.GetHands())
+				if i > 0 && tt.Request.GetRanking() == pb.LeaderboardRanking_POINTS {
+					require.LessOrEqual(t, st.GetPoints(), board.GetStandings()[i-1].GetPoints())
+				} else if i > 0 {
+					require.LessOrEqual(t, st.GetNet(), board.GetStandings()[i-1].GetNet())
+				}
+				net += st.GetNet()
+			}
+			// there's no rake so the chips won and lost on a full board cancel out
+			if tt.ExpStandings == int(tt.ExpTotal) {
+				require.Equal(t, int64(0), net)
+			}
+		})
+	}
+
+	// the caught up standings are the same as the ones added as the hands were settled
+	for _, ranking := range []pb.LeaderboardRanking{pb.LeaderboardRanking_NET, pb.LeaderboardRanking_POINTS} {
+		board, err := testClient.GetLeaderboard(ctx, &pb.LeaderboardRequest{Season: season.GetId(), Ranking: ranking})
+		require.NoError(t, err)
+		caughtUp, err := testClient.GetLeaderboard(ctx, &pb.LeaderboardRequest{Season: late.GetId(), Ranking: ranking})
+		require.NoError(t, err)
+		require.Equal(t, board.GetStandings(), caughtUp.GetStandings())
+	}
+
+	_, err = testClient.CreateSeason(ctx, &pb.Season{Name: season.GetName(), Start: now, End: now + 1})
+	require.EqualError(t, err, rpcError(server.ErrSeasonNameExists.Error()))
+	_, err = testClient.CreateSeason(ctx, &pb.Season{Name: getUniqueName(), Start: now, End: now})
+	require.EqualError(t, err, rpcError(server.ErrInvalidDateRange.Error()))
+	_, err = testClient.CreateSeason(ctx, &pb.Season{Start: now, End: now + 1})
+	require.EqualError(t, err, rpcError(server.ErrEmptySeasonName.Error()))
+}
+
+func TestServer_ListGames(t *testing.T) {
+	ctx := context.Background()
+	// stakes no other test plays, so only these games are listed
+	const small, big = 7000, 14000
+
+	players := []*pb.Player{}
+	for i := 0; i < 7; i++ {
+		players = append(players, &pb.Player{Name: getUniqueName(), Chips: 100000})
+	}
+	_, err := testClient.CreatePlayers(ctx, &pb.Players{Players: players})
+	require.NoError(t, err)
+
+	// a hold'em game with 7 players, an empty omaha game and an empty game with blinds from its min
+	holdem, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), SmallBlind: small, BigBlind: big})
+	require.NoError(t, err)
+	holdem.Players = &pb.Players{Players: players}
+	_, err = testClient.SetGamePlayers(ctx, holdem)
+	require.NoError(t, err)
+	omaha, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), SmallBlind: small, BigBlind: big, Variant: pb.GameVariant_OMAHA})
+	require.NoError(t, err)
+	derived, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), Min: small})
+	require.NoError(t, err)
+
+	tests := []struct {
+		Name     string
+		Request  *pb.ListGamesRequest
+		ExpGames []int64
+		ExpTotal int64
+	}{
+		{
+			Name:     "Stakes",
+			Request:  &pb.ListGamesRequest{BigBlind: big},
+			ExpGames: []int64{holdem.GetId(), omaha.GetId(), derived.GetId()},
+			ExpTotal: 3,
+		},
+		{
+			Name:     "Variant",
+			Request:  &pb.ListGamesRequest{BigBlind: big, ByVariant: true, Variant: pb.GameVariant_OMAHA},
+			ExpGames: []int64{omaha.GetId()},
+			ExpTotal: 1,
+		},
+		{
+			Name:     "Seats available",
+			Request:  &pb.ListGamesRequest{BigBlind: big, SeatsAvailable: 2},
+			ExpGames: []int64{omaha.GetId(), derived.GetId()},
+			ExpTotal: 2,
+		},
+		{
+			Name:     "In round",
+			Request:  &pb.ListGamesRequest{BigBlind: big, ByInRound: true, InRound: true},
+			ExpGames: []int64{},
+			ExpTotal: 0,
+		},
+		{
+			Name:     "Not in round",
+			Request:  &pb.ListGamesRequest{BigBlind: big, ByInRound: true},
+			ExpGames: []int64{holdem.GetId(), omaha.GetId(), derived.GetId()},
+			ExpTotal: 3,
+		},
+		{
+			Name:     "Second page",
+			Request:  &pb.ListGamesRequest{BigBlind: big, Page: 1, PageSize: 2},
+			ExpGames: []int64{derived.GetId()},
+			ExpTotal: 3,
+		},
+		{
+			Name:     "Past the last page",
+			Request:  &pb.ListGamesRequest{BigBlind: big, Page: 3, PageSize: 1},
+			ExpGames: []int64{},
+			ExpTotal: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			list, err := testClient.ListGames(ctx, tt.Request)
+			require.NoError(t, err)
+			require.Equal(t, tt.ExpTotal, list.GetTotal())
+			ids := []int64{}
+			for _, g := range list.GetGames() {
+				ids = append(ids, g.GetId())
+				require.Equal(t, int64(small), g.GetSmallBlind())
+				require.Equal(t, int64(big), g.GetBigBlind())
+				require.Equal(t, int64(8), g.GetSeats())
+			}
+			require.Equal(t, tt.ExpGames, ids)
+		})
+	}
+}
+
+func TestServer_WatchLobby(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	const small, big = 9000, 18000
+
+	// watches filtered the same as listing the games
+	watch := func(in *pb.WatchLobbyRequest) pb.Poker_WatchLobbyClient {
+		stream, err := testClient.WatchLobby(ctx, in)
+		require.NoError(t, err)
+		// the watch has started once the headers are sent
+		_, err = stream.Header()
+		require.NoError(t, err)
+		return stream
+	}
+	stream := watch(&pb.WatchLobbyRequest{BigBlind: big})
+	open := watch(&pb.WatchLobbyRequest{BigBlind: big, SeatsAvailable: 7})
+	inRound := watch(&pb.WatchLobbyRequest{BigBlind: big, ByInRound: true, InRound: true})
+
+	// games at other stakes aren't pushed
+	_, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), SmallBlind: small, BigBlind: big * 2})
+	require.NoError(t, err)
+
+	players := []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}
+	created, err := testClient.CreatePlayers(ctx, &pb.Players{Players: players})
+	require.NoError(t, err)
+	game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), SmallBlind: small, BigBlind: big})
+	require.NoError(t, err)
+	game.Players = &pb.Players{Players: players}
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	_, err = testClient.UpdateGameInRound(ctx, game)
+	require.NoError(t, err)
+	_, err = testClient.UpdateGameStatus(ctx, game)
+	require.NoError(t, err)
+	_, err = testClient.RemovePlayerFromGame(ctx, created.GetPlayers()[0])
+	require.NoError(t, err)
+
+	expected := []struct {
+		Players int64
+		InRound bool
+	}{
+		// created, players joined, a round started and finished, a player left
+		{0, false},
+		{2, false},
+		{2, true},
+		{2, false},
+		{1, false},
+	}
+	for _, exp := range expected {
+		g, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, game.GetId(), g.GetId())
+		require.Equal(t, game.GetName(), g.GetName())
+		require.Equal(t, exp.Players, g.GetPlayers())
+		require.Equal(t, exp.InRound, g.GetInRound())
+	}
+
+	// the 8 seat game only has 7 open seats with no more than 1 player, it's unmatched once
+	// when the players join and isn't pushed again until one leaves
+	for _, exp := range []struct {
+		Players   int64
+		Unmatched bool
+	}{{0, false}, {2, true}, {1, false}} {
+		g, err := open.Recv()
+		require.NoError(t, err)
+		require.Equal(t, game.GetId(), g.GetId())
+		require.Equal(t, exp.Players, g.GetPlayers())
+		require.Equal(t, exp.Unmatched, g.GetUnmatched())
+	}
+	// unmatched when the round finishes, the game never matched before the round started
+	for _, exp := range []bool{true, false} {
+		g, err := inRound.Recv()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), g.GetPlayers())
+		require.Equal(t, exp, g.GetInRound())
+		require.Equal(t, !exp, g.GetUnmatched())
+	}
+
+	// a game listed before the watch started is unmatched the first time it doesn't match
+	listed := watch(&pb.WatchLobbyRequest{BigBlind: big, SeatsAvailable: 7})
+	game.Players = &pb.Players{Players: []*pb.Player{{Name: getUniqueName()}, {Name: getUniqueName()}}}
+	_, err = testClient.CreatePlayers(ctx, game.GetPlayers())
+	require.NoError(t, err)
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	g, err := listed.Recv()
+	require.NoError(t, err)
+	require.Equal(t, game.GetId(), g.GetId())
+	require.True(t, g.GetUnmatched())
+}
+
+func TestServer_SeatsAndWaitlist(t *testing.T) {
+	ctx := context.Background()
+
+	players := []*pb.Player{}
+	for i := 0; i < 11; i++ {
+		players = append(players, &pb.Player{Name: getUniqueName(), Chips: 1000})
+	}
+	created, err := testClient.CreatePlayers(ctx, &pb.Players{Players: players})
+	require.NoError(t, err)
+	game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+	require.NoError(t, err)
+
+	seats := func() map[int64]int64 {
+		g, err := testClient.GetGame(ctx, game)
+		require.NoError(t, err)
+		out := map[int64]int64{}
+		for _, p := range g.GetPlayers().GetPlayers() {
+			out[p.GetId()] = p.GetSlot()
+		}
+		return out
+	}
+	allocate := func() map[int64]int64 {
+		g, err := testClient.GetGame(ctx, game)
+		require.NoError(t, err)
+		_, err = testClient.AllocateGameSlots(ctx, g)
+		require.NoError(t, err)
+		return seats()
+	}
+	ids := []int64{}
+	for _, p := range created.GetPlayers() {
+		ids = append(ids, p.GetId())
+	}
+
+	// the third player asks for seat 5, the others are seated when the slots are allocated
+	game.Players = &pb.Players{Players: []*pb.Player{
+		{Name: players[0].GetName()},
+		{Name: players[1].GetName()},
+		{Name: players[2].GetName(), Slot: 5},
+	}}
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	require.Equal(t, map[int64]int64{ids[0]: 0, ids[1]: 0, ids[2]: 5}, seats())
+	require.Equal(t, map[int64]int64{ids[0]: 1, ids[1]: 2, ids[2]: 5}, allocate())
+
+	// taken seats can't be joined or moved to
+	game.Players = &pb.Players{Players: []*pb.Player{{Name: players[3].GetName(), Slot: 5}}}
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.EqualError(t, err, rpcError(server.ErrSeatTaken.Error()))
+	_, err = testClient.SetPlayerSlot(ctx, &pb.Player{Id: ids[0], Slot: 2})
+	require.EqualError(t, err, rpcError(server.ErrSeatTaken.Error()))
+
+	// seats are kept when a player leaves and the slots are allocated again
+	_, err = testClient.RemovePlayerFromGame(ctx, &pb.Player{Id: ids[1]})
+	require.NoError(t, err)
+	require.Equal(t, map[int64]int64{ids[0]: 1, ids[2]: 5}, allocate())
+
+	// the game fills up and the last 2 players wait
+	game.Players = &pb.Players{}
+	for _, p := range players[3:] {
+		game.Players.Players = append(game.Players.Players, &pb.Player{Name: p.GetName()})
+	}
+	joined, err := testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(joined.GetPlayers()))
+	waitlist, err := testClient.GetWaitlist(ctx, game)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(waitlist.GetPlayers().GetPlayers()))
+	require.Equal(t, ids[9], waitlist.GetPlayers().GetPlayers()[0].GetId())
+	require.Equal(t, ids[10], waitlist.GetPlayers().GetPlayers()[1].GetId())
+	allocated := allocate()
+	require.Equal(t, int64(1), allocated[ids[0]])
+	require.Equal(t, int64(5), allocated[ids[2]])
+	require.Equal(t, int64(2), allocated[ids[3]])
+
+	// a player asking for a seat at the full game isn't waitlisted
+	late, err := testClient.CreatePlayers(ctx, &pb.Players{Players: []*pb.Player{{Name: getUniqueName(), Chips: 1000}}})
+	require.NoError(t, err)
+	game.Players = &pb.Players{Players: []*pb.Player{{Name: late.GetPlayers()[0].GetName(), Slot: 3}}}
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.EqualError(t, err, rpcError(server.ErrSeatTaken.Error()))
+	waitlist, err = testClient.GetWaitlist(ctx, game)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(waitlist.GetPlayers().GetPlayers()))
+
+	// a seat opens and the longest waiting player takes it
+	_, err = testClient.RemovePlayerFromGame(ctx, &pb.Player{Id: ids[2]})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), seats()[ids[9]])
+	waitlist, err = testClient.GetWaitlist(ctx, game)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(waitlist.GetPlayers().GetPlayers()))
+
+	waitlist, err = testClient.LeaveWaitlist(ctx, &pb.Waitlist{Game: game.GetId(), Players: &pb.Players{Players: []*pb.Player{{Id: ids[10]}}}})
+	require.NoError(t, err)
+	require.Empty(t, waitlist.GetPlayers().GetPlayers())
+
+	// a player's seat is the same in each of their games, so it has to be open in all of them
+	both, err := testClient.CreatePlayers(ctx, &pb.Players{Players: []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}})
+	require.NoError(t, err)
+	first, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+	require.NoError(t, err)
+	first.Players = &pb.Players{Players: []*pb.Player{{Name: both.GetPlayers()[0].GetName(), Slot: 2}}}
+	_, err = testClient.SetGamePlayers(ctx, first)
+	require.NoError(t, err)
+	second, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+	require.NoError(t, err)
+	second.Players = &pb.Players{Players: []*pb.Player{
+		{Name: both.GetPlayers()[1].GetName(), Slot: 3},
+		{Name: both.GetPlayers()[0].GetName(), Slot: 4},
+	}}
+	_, err = testClient.SetGamePlayers(ctx, second)
+	require.NoError(t, err)
+	_, err = testClient.SetPlayerSlot(ctx, &pb.Player{Id: both.GetPlayers()[0].GetId(), Slot: 3})
+	require.EqualError(t, err, rpcError(server.ErrSeatTaken.Error()))
+	moved, err := testClient.SetPlayerSlot(ctx, &pb.Player{Id: both.GetPlayers()[0].GetId(), Slot: 5})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), moved.GetSlot())
+
+	// joining another game can't take a seat from a player at one of the joining player's games,
+	// and a player joining without asking for a seat keeps theirs
+	rv, err := testClient.CreatePlayers(ctx, &pb.Players{Players: []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}})
+	require.NoError(t, err)
+	rv1, rv2 := rv.GetPlayers()[0], rv.GetPlayers()[1]
+	a, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+	require.NoError(t, err)
+	a.Players = &pb.Players{Players: []*pb.Player{{Name: rv1.GetName(), Slot: 1}, {Name: rv2.GetName(), Slot: 2}}}
+	_, err = testClient.SetGamePlayers(ctx, a)
+	require.NoError(t, err)
+	b, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName()})
+	require.NoError(t, err)
+	b.Players = &pb.Players{Players: []*pb.Player{{Name: rv1.GetName(), Slot: 2}}}
+	_, err = testClient.SetGamePlayers(ctx, b)
+	require.EqualError(t, err, rpcError(server.ErrSeatTaken.Error()))
+	b.Players = &pb.Players{Players: []*pb.Player{{Name: rv1.GetName()}}}
+	_, err = testClient.SetGamePlayers(ctx, b)
+	require.NoError(t, err)
+	for _, g := range []*pb.Game{a, b} {
+		g, err := testClient.GetGame(ctx, g)
+		require.NoError(t, err)
+		for _, p := range g.GetPlayers().GetPlayers() {
+			require.Equal(t, map[int64]int64{rv1.GetId(): 1, rv2.GetId(): 2}[p.GetId()], p.GetSlot())
+		}
+	}
+
+	// a waiting player whose seat is taken keeps waiting while the next player takes the open seat
+	heads, err := testClient.CreatePlayers(ctx, &pb.Players{Players: []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}})
+	require.NoError(t, err)
+	hu, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), MaxSeats: 2})
+	require.NoError(t, err)
+	hu.Players = &pb.Players{Players: []*pb.Player{
+		{Name: heads.GetPlayers()[0].GetName(), Slot: 1},
+		{Name: heads.GetPlayers()[1].GetName(), Slot: 2},
+	}}
+	_, err = testClient.SetGamePlayers(ctx, hu)
+	require.NoError(t, err)
+	hu.Players = &pb.Players{Players: []*pb.Player{{Name: rv2.GetName()}, {Name: heads.GetPlayers()[2].GetName()}}}
+	_, err = testClient.SetGamePlayers(ctx, hu)
+	require.NoError(t, err)
+	waitlist, err = testClient.GetWaitlist(ctx, hu)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(waitlist.GetPlayers().GetPlayers()))
+
+	huSeats := func() map[int64]int64 {
+		g, err := testClient.GetGame(ctx, hu)
+		require.NoError(t, err)
+		out := map[int64]int64{}
+		for _, p := range g.GetPlayers().GetPlayers() {
+			out[p.GetId()] = p.GetSlot()
+		}
+		return out
+	}
+	_, err = testClient.RemovePlayerFromGame(ctx, &pb.Player{Id: heads.GetPlayers()[0].GetId()})
+	require.NoError(t, err)
+	require.Equal(t, map[int64]int64{heads.GetPlayers()[1].GetId(): 2, heads.GetPlayers()[2].GetId(): 1}, huSeats())
+	_, err = testClient.RemovePlayerFromGame(ctx, &pb.Player{Id: heads.GetPlayers()[1].GetId()})
+	require.NoError(t, err)
+	require.Equal(t, map[int64]int64{rv2.GetId(): 2, heads.GetPlayers()[2].GetId(): 1}, huSeats())
+	waitlist, err = testClient.GetWaitlist(ctx, hu)
+	require.NoError(t, err)
+	require.Empty(t, waitlist.GetPlayers().GetPlayers())
+}
+
+func TestServer_MaxSeats(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), MaxSeats: 7})
+	require.EqualError(t, err, rpcError(server.ErrInvalidMaxSeats.Error()))
+
+	newPlayers := func(n int) []*pb.Player {
+		players := []*pb.Player{}
+		for i := 0; i < n; i++ {
+			players = append(players, &pb.Player{Name: getUniqueName(), Chips: 1000})
+		}
+		created, err := testClient.CreatePlayers(ctx, &pb.Players{Players: players})
+		require.NoError(t, err)
+		return created.GetPlayers()
+	}
+
+	t.Run("Heads up table", func(t *testing.T) {
+		players := newPlayers(3)
+		game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), MaxSeats: 2})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), game.GetMaxSeats())
+		game.Players = &pb.Players{Players: players}
+		joined, err := testClient.SetGamePlayers(ctx, game)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(joined.GetPlayers()))
+		waitlist, err := testClient.GetWaitlist(ctx, game)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(waitlist.GetPlayers().GetPlayers()))
+
+		_, err = testClient.SetPlayerSlot(ctx, &pb.Player{Id: players[0].GetId(), Slot: 3})
+		require.EqualError(t, err, rpcError(server.ErrInvalidSlotMinMax.Error()))
+	})
+
+	t.Run("Sparse 9 max table", func(t *testing.T) {
+		players := newPlayers(4)
+		seats := []int64{2, 5, 7, 9}
+		game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), MaxSeats: 9, SmallBlind: 11, BigBlind: 22})
+		require.NoError(t, err)
+		game.Players = &pb.Players{}
+		for i, p := range players {
+			game.Players.Players = append(game.Players.Players, &pb.Player{Name: p.GetName(), Slot: seats[i]})
+		}
+		_, err = testClient.SetGamePlayers(ctx, game)
+		require.NoError(t, err)
+
+		// stakes no other test plays, so only this game is listed
+		list, err := testClient.ListGames(ctx, &pb.ListGamesRequest{BigBlind: 22, SeatsAvailable: 5})
+		require.NoError(t, err)
+		found := false
+		for _, g := range list.GetGames() {
+			if g.GetId() == game.GetId() {
+				found = true
+				require.Equal(t, int64(9), g.GetSeats())
+				require.Equal(t, int64(4), g.GetPlayers())
+			}
+		}
+		require.True(t, found)
+
+		// the table size comes from the game, so seat 9 is kept when it's left out
+		game, err = testClient.GetGame(ctx, game)
+		require.NoError(t, err)
+		game.MaxSeats = 0
+		game, err = testClient.AllocateGameSlots(ctx, game)
+		require.NoError(t, err)
+		game, err = testClient.SetButtonPositions(ctx, game)
+		require.NoError(t, err)
+		require.Contains(t, seats, game.GetDealer())
+
+		// the dealer leaves, so the button is on an empty seat and the next seat round the table deals
+		left := map[int64]bool{}
+		remaining := []int64{}
+		for _, p := range game.GetPlayers().GetPlayers() {
+			require.Contains(t, seats, p.GetSlot())
+			if p.GetSlot() == game.GetDealer() {
+				_, err = testClient.RemovePlayerFromGame(ctx, p)
+				require.NoError(t, err)
+				left[p.GetId()] = true
+				continue
+			}
+			remaining = append(remaining, p.GetSlot())
+		}
+		require.Equal(t, 3, len(remaining))
+		sort.Slice(remaining, func(i, j int) bool { return remaining[i] < remaining[j] })
+		next := 0
+		for next < len(remaining) && remaining[next] < game.GetDealer() {
+			next++
+		}
+		expSmall := remaining[(next+1)%len(remaining)]
+		expBig := remaining[(next+2)%len(remaining)]
+
+		game, err = testClient.GetGame(ctx, game)
+		require.NoError(t, err)
+		round, err := testClient.CreateRoundFromGame(ctx, game)
+		require.NoError(t, err)
+		round, err = testClient.ValidatePreRound(ctx, round)
+		require.NoError(t, err)
+		round, err = testClient.StartRound(ctx, round)
+		require.NoError(t, err)
+
+		bets, err := testClient.GetRoundBets(ctx, round)
+		require.NoError(t, err)
+		slots := map[int64]int64{}
+		for _, p := range round.GetPlayers().GetPlayers() {
+			slots[p.GetId()] = p.GetSlot()
+		}
+		for _, b := range bets.GetBets() {
+			switch b.GetType() {
+			case pb.Bet_SMALL:
+				require.Equal(t, expSmall, slots[b.GetPlayer()])
+			case pb.Bet_BIG:
+				require.Equal(t, expBig, slots[b.GetPlayer()])
+			}
+		}
+
+		round = playToShowdown(t, ctx, round)
+		require.Equal(t, pb.RoundStatus_OVER, round.GetStatus())
+		require.NotZero(t, round.GetWinningPlayer())
+		require.False(t, left[round.GetWinningPlayer()])
+	})
+}
+
+func TestServer_Spectate(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	players := []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}
+	game := &pb.Game{
+		Name:       getUniqueName(),
+		Players:    &pb.Players{Players: players},
+		SmallBlind: 10,
+		BigBlind:   20,
+	}
+	round, _, game := setupGame(t, &pb.Players{Players: players}, game)
+	seated := game.GetPlayers().GetPlayers()[0]
+
+	watchers, err := testClient.CreatePlayers(ctx, &pb.Players{Players: []*pb.Player{{Name: getUniqueName(), Chips: 1000}}})
+	require.NoError(t, err)
+	watcher := watchers.GetPlayers()[0]
+
+	// spectators have to be a player, and not one at the table
+	anonStream, err := testClient.Spectate(ctx, &pb.SpectateRequest{Game: game.GetId()})
+	require.NoError(t, err)
+	_, err = anonStream.Recv()
+	require.EqualError(t, err, rpcError(server.ErrPlayerDoesntExist.Error()))
+	seatedStream, err := testClient.Spectate(ctx, &pb.SpectateRequest{Game: game.GetId(), Player: seated.GetId()})
+	require.NoError(t, err)
+	_, err = seatedStream.Recv()
+	require.EqualError(t, err, rpcError(server.ErrSpectatorSeated.Error()))
+
+	const delay = 200
+	stream, err := testClient.Spectate(ctx, &pb.SpectateRequest{Game: game.GetId(), Player: watcher.GetId(), Delay: delay})
+	require.NoError(t, err)
+	_, err = stream.Header()
+	require.NoError(t, err)
+
+	// spectators can't act at the table
+	_, err = testClient.MakeBet(ctx, &pb.Bet{Player: watcher.GetId(), Game: game.GetId(), Round: round.GetId(), Type: pb.Bet_CALL})
+	require.EqualError(t, err, rpcError(server.ErrSpectating.Error()))
+	_, err = testClient.AgreeToRuns(ctx, &pb.RunAgreement{Player: watcher.GetId(), Round: round.GetId(), Runs: 2})
+	require.EqualError(t, err, rpcError(server.ErrSpectating.Error()))
+	_, err = testClient.SetGamePlayers(ctx, &pb.Game{Id: game.GetId(), Players: &pb.Players{Players: []*pb.Player{{Name: watcher.GetName()}}}})
+	require.EqualError(t, err, rpcError(server.ErrSpectating.Error()))
+
+	round = playToShowdown(t, ctx, round)
+	bets, err := testClient.GetRoundBets(ctx, round)
+	require.NoError(t, err)
+	var bet int64
+	for _, b := range bets.GetBets() {
+		bet += b.GetChips()
+	}
+
+	var pot int64
+	shown := 0
+	for {
+		e, err := stream.Recv()
+		require.NoError(t, err)
+		ev := e.GetEvent()
+		require.Equal(t, round.GetId(), ev.GetRound())
+		require.Empty(t, ev.GetDeck())
+		// events are held back by the delay
+		require.True(t, time.Now().UnixNano()/1e6 >= ev.GetTime()+delay)
+		switch ev.GetType() {
+		case pb.HandEventType_DEAL:
+			if ev.GetPlayer() != 0 {
+				require.Empty(t, ev.GetCards())
+			} else {
+				require.NotEmpty(t, ev.GetCards())
+			}
+		case pb.HandEventType_SHOWDOWN:
+			require.NotEmpty(t, ev.GetCards())
+			shown++
+		case pb.HandEventType_SETTLE:
+			require.Empty(t, ev.GetCards())
+		}
+		pot = e.GetPot()
+		if ev.GetType() == pb.HandEventType_SETTLE {
+			break
+		}
+	}
+	require.Equal(t, bet, pot)
+	require.Equal(t, len(players), shown)
+}
+
+func TestServer_SpectatorSeatedFromWaitlist(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	players := []*pb.Player{
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+		{Name: getUniqueName(), Chips: 1000},
+	}
+	created, err := testClient.CreatePlayers(ctx, &pb.Players{Players: players})
+	require.NoError(t, err)
+	game, err := testClient.CreateGame(ctx, &pb.Game{Name: getUniqueName(), MaxSeats: 2, SmallBlind: 10, BigBlind: 20})
+	require.NoError(t, err)
+	game.Players = &pb.Players{Players: players}
+	_, err = testClient.SetGamePlayers(ctx, game)
+	require.NoError(t, err)
+	waiting := created.GetPlayers()[2]
+
+	// the waitlisted player watches while they wait
+	stream, err := testClient.Spectate(ctx, &pb.SpectateRequest{Game: game.GetId(), Player: waiting.GetId()})
+	require.NoError(t, err)
+	_, err = stream.Header()
+	require.NoError(t, err)
+
+	// and is seated when a player leaves, but can't move or start a round until they stop spectating
+	_, err = testClient.RemovePlayerFromGame(ctx, created.GetPlayers()[1])
+	require.NoError(t, err)
+	game, err = testClient.GetGame(ctx, game)
+	require.NoError(t, err)
+	require.Len(t, game.GetPlayers().GetPlayers(), 2)
+	_, err = testClient.SetPlayerSlot(ctx, &pb.Player{Id: waiting.GetId(), Slot: 2})
+	require.EqualError(t, err, rpcError(server.ErrSpectating.Error()))
+
+	game, err = testClient.AllocateGameSlots(ctx, game)
+	require.NoError(t, err)
+	game, err = testClient.SetButtonPositions(ctx, game)
+	require.NoError(t, err)
+	game.Min = minChips
+	game, err = testClient.SetMin(ctx, game)
+	require.NoError(t, err)
+	round, err := testClient.CreateRoundFromGame(ctx, game)
+	require.NoError(t, err)
+	round, err = testClient.ValidatePreRound(ctx, round)
+	require.NoError(t, err)
+	_, err = testClient.StartRound(ctx, round)
+	require.EqualError(t, err, rpcError(server.ErrSpectating.Error()))
 }
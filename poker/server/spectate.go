@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/metadata"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+/*
+	Spectators watch a game's hands from its event log.
+
+	Only what's public at the table is sent: the deck is never shown, hole cards only
+	when they're shown down, and stud cards only when they're dealt face up. Events
+	can be held back by a delay so what's seen can't be used at the table.
+
+	Players seated at a game can't spectate it, and players spectating a game can't join it.
+	A waitlisted player can watch while they wait, but once they're seated from the waitlist
+	they can't act, move seats or start a round until they stop spectating.
+*/
+
+// events buffered for each stream, a stream that falls further behind catches up from the event log
+const spectateBuffer = 256
+
+// spectators are the players spectating each game, and the streams following each game's events
+type spectators struct {
+	sync.Mutex
+	// streams of each player by game, a player can spectate a game more than once
+	games   map[int64]map[int64]int
+	next    int
+	streams map[int64]map[int]chan *models.HandEvent
+}
+
+func newSpectators() *spectators {
+	return &spectators{games: map[int64]map[int64]int{}, streams: map[int64]map[int]chan *models.HandEvent{}}
+}
+
+func (sp *spectators) add(game, player int64) {
+	sp.Lock()
+	defer sp.Unlock()
+	if sp.games[game] == nil {
+		sp.games[game] = map[int64]int{}
+	}
+	sp.games[game][player]++
+}
+
+func (sp *spectators) remove(game, player int64) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.games[game][player]--
+	if sp.games[game][player] <= 0 {
+		delete(sp.games[game], player)
+	}
+}
+
+func (sp *spectators) spectating(game, player int64) bool {
+	sp.Lock()
+	defer sp.Unlock()
+	return sp.games[game][player] > 0
+}
+
+func (sp *spectators) watch(game int64) (int, chan *models.HandEvent) {
+	sp.Lock()
+	defer sp.Unlock()
+	sp.next++
+	c := make(chan *models.HandEvent, spectateBuffer)
+	if sp.streams[game] == nil {
+		sp.streams[game] = map[int]chan *models.HandEvent{}
+	}
+	sp.streams[game][sp.next] = c
+	return sp.next, c
+}
+
+func (sp *spectators) stop(game int64, id int) {
+	sp.Lock()
+	defer sp.Unlock()
+	delete(sp.streams[game], id)
+	if len(sp.streams[game]) == 0 {
+		delete(sp.streams, game)
+	}
+}
+
+// publish sends a logged event to the streams following its game. A stream with a full buffer is
+// closed, rather than missing the event, and catches up from the event log
+func (sp *spectators) publish(e *models.HandEvent) {
+	sp.Lock()
+	defer sp.Unlock()
+	for id, c := range sp.streams[e.Game] {
+		select {
+		case c <- e:
+		default:
+			close(c)
+			delete(sp.streams[e.Game], id)
+		}
+	}
+}
+
+// seatedSpectator checks none of a game's players are spectating it, which a player seated from the
+// waitlist can be
+func (s *Server) seatedSpectator(ctx context.Context, game int64) error {
+	g, err := s.GetGame(ctx, &pb.Game{Id: game})
+	if err != nil {
+		return err
+	}
+	for _, p := range g.GetPlayers().GetPlayers() {
+		if s.spectators.spectating(game, p.GetId()) {
+			return ErrSpectating
+		}
+	}
+	return nil
+}
+
+// Spectate streams the public view of a game's hands, starting with the hand being played, until the
+// stream is closed. Headers are sent once the spectator is registered, so a client can wait on them
+// before the player is kept from acting
+func (s *Server) Spectate(in *pb.SpectateRequest, stream pb.Poker_SpectateServer) error {
+	ctx := stream.Context()
+	if _, err := s.GetPlayer(ctx, &pb.Player{Id: in.GetPlayer()}); err == gorm.ErrRecordNotFound {
+		return ErrPlayerDoesntExist
+	} else if err != nil {
+		return err
+	}
+	g, err := s.GetGame(ctx, &pb.Game{Id: in.GetGame()})
+	if err != nil {
+		return err
+	}
+	for _, p := range g.GetPlayers().GetPlayers() {
+		if p.GetId() == in.GetPlayer() {
+			return ErrSpectatorSeated
+		}
+	}
+	s.spectators.add(g.GetId(), in.GetPlayer())
+	defer s.spectators.remove(g.GetId(), in.GetPlayer())
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	// events after the last one sent, starting from the latest hand's start
+	after := uint(0)
+	start := &models.HandEvent{}
+	if err := s.gormDb.Where("game = ? AND type = ?", g.GetId(), pb.HandEventType_START.String()).Order("id DESC").First(start).Error; err == nil {
+		after = start.ID - 1
+	} else if err != gorm.ErrRecordNotFound {
+		return err
+	}
+
+	delay := time.Duration(in.GetDelay()) * time.Millisecond
+	pot := int64(0)
+	send := func(e *models.HandEvent) error {
+		// events already sent from the log
+		if e.ID <= after {
+			return nil
+		}
+		if wait := time.Until(e.CreatedAt.Add(delay)); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		event := publicEvent(e.ProtoMarshal())
+		switch event.GetType() {
+		case pb.HandEventType_START:
+			pot = 0
+		case pb.HandEventType_BET:
+			pot += event.GetChips()
+		}
+		if err := stream.Send(&pb.SpectatorEvent{Event: event, Pot: pot}); err != nil {
+			return err
+		}
+		after = e.ID
+		return nil
+	}
+
+	// follow the game's events, then catch up from the log with what's been logged since.
+	// Following starts first so nothing logged in between is missed
+	for {
+		id, events := s.spectators.watch(g.GetId())
+		err := func() error {
+			defer s.spectators.stop(g.GetId(), id)
+			var logged []*models.HandEvent
+			if err := s.gormDb.Where("game = ? AND id > ?", g.GetId(), after).Order("id").Find(&logged).Error; err != nil {
+				return err
+			}
+			for _, e := range logged {
+				if err := send(e); err != nil {
+					return err
+				}
+			}
+			for {
+				select {
+				case e, ok := <-events:
+					// the stream fell behind and was closed
+					if !ok {
+						return nil
+					}
+					if err := send(e); err != nil {
+						return err
+					}
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// publicEvent clears what the table can't see from an event
+func publicEvent(e *pb.HandEvent) *pb.HandEvent {
+	e.Deck = ""
+	switch e.GetType() {
+	case pb.HandEventType_DEAL:
+		// board cards are dealt without a player
+		if e.GetPlayer() != 0 {
+			e.Cards = ""
+		}
+	case pb.HandEventType_SETTLE:
+		// cards are only seen if they're shown down
+		e.Cards = ""
+	}
+	return e
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+/*
+	Player stats, from the bets of settled hands.
+
+	When a pot is settled each player's hand is summarised in a player hand row, what they
+	did on the first street, how aggressive they were after it and whether they went to
+	showdown. Stats for any filter are then sums over the matching rows, so nothing is
+	recalculated from the bets once a hand is complete.
+
+	The first street is pre flop, or third street in stud. The engine has no separate bet
+	type, so the first raise on a street is the bet, and a call of no chips is a check.
+*/
+
+// recordPlayerHands summarises each player's part in a settled round
+func (s *Server) recordPlayerHands(ctx context.Context, r *pb.Round, g *pb.Game, awards map[int64]int64) error {
+	bets, err := s.GetRoundBets(ctx, r)
+	if err != nil {
+		return err
+	}
+	_, big := gameBlinds(g)
+
+	inHand := 0
+	hands := map[int64]*models.PlayerHand{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if p.GetInHand() {
+			inHand++
+		}
+		hands[p.GetId()] = &models.PlayerHand{
+			Game:     r.GetGame(),
+			Round:    r.GetId(),
+			Player:   p.GetId(),
+			BigBlind: big,
+			Net:      awards[p.GetId()],
+		}
+	}
+
+	first := pb.RoundStatus_PRE_FLOP
+	if isStud(r.GetVariant()) {
+		first = pb.RoundStatus_THIRD_STREET
+	}
+	raises := 0
+	folded := map[int64]bool{}
+	for _, b := range bets.GetBets() {
+		h, ok := hands[b.GetPlayer()]
+		if !ok {
+			continue
+		}
+		h.Net -= b.GetChips()
+
+		if b.GetStatus() != first {
+			switch {
+			case b.GetType() == pb.Bet_RAISE:
+				h.Aggressive++
+			case b.GetType() == pb.Bet_CALL && b.GetChips() > 0:
+				h.Calls++
+			}
+			continue
+		}
+
+		switch b.GetType() {
+		case pb.Bet_FOLD:
+			folded[b.GetPlayer()] = true
+		case pb.Bet_CALL:
+			h.Vpip = h.Vpip || b.GetChips() > 0
+		case pb.Bet_RAISE:
+			h.Vpip = true
+			h.Pfr = true
+		default:
+			// blinds and the bring in are forced
+			continue
+		}
+		if raises == 1 {
+			h.ThreeBetChance = true
+			h.ThreeBet = h.ThreeBet || b.GetType() == pb.Bet_RAISE
+		}
+		if b.GetType() == pb.Bet_RAISE {
+			raises++
+		}
+	}
+
+	flop := sawFlop(r)
+	out := []*models.PlayerHand{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		h := hands[p.GetId()]
+		h.SawFlop = flop && !folded[p.GetId()]
+		h.Showdown = inHand > 1 && p.GetInHand()
+		h.WonShowdown = h.Showdown && awards[p.GetId()] > 0
+		if err := s.gormDb.Create(h).Error; err != nil {
+			return err
+		}
+		out = append(out, h)
+	}
+	return s.updateStandings(ctx, r, out)
+}
+
+// GetPlayerStats totals a player's stats over the settled hands matching the request's filters
+func (s *Server) GetPlayerStats(ctx context.Context, in *pb.PlayerStatsRequest) (*pb.PlayerStats, error) {
+	if in.GetFrom() != 0 && in.GetTo() != 0 && in.GetFrom() >= in.GetTo() {
+		return nil, ErrInvalidDateRange
+	}
+	p, err := s.GetPlayer(ctx, &pb.Player{Id: in.GetPlayer()})
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrPlayerDoesntExist
+	} else if err != nil {
+		return nil, err
+	}
+
+	q := s.gormDb.Where("player = ?", p.GetId())
+	if in.GetGame() != 0 {
+		q = q.Where("game = ?", in.GetGame())
+	}
+	if in.GetBigBlind() != 0 {
+		q = q.Where("big_blind = ?", in.GetBigBlind())
+	}
+	if in.GetFrom() != 0 {
+		q = q.Where("created_at >= ?", time.Unix(0, in.GetFrom()*1e6))
+	}
+	if in.GetTo() != 0 {
+		q = q.Where("created_at < ?", time.Unix(0, in.GetTo()*1e6))
+	}
+	var hands []*models.PlayerHand
+	if err := q.Find(&hands).Error; err != nil {
+		return nil, err
+	}
+
+	var vpip, pfr, threeBets, threeBetChances, aggressive, calls, flops, showdowns, won int64
+	out := &pb.PlayerStats{Player: p.GetId(), Hands: int64(len(hands))}
+	for _, h := range hands {
+		vpip += boolCount(h.Vpip)
+		pfr += boolCount(h.Pfr)
+		threeBets += boolCount(h.ThreeBet)
+		threeBetChances += boolCount(h.ThreeBetChance)
+		aggressive += h.Aggressive
+		calls += h.Calls
+		flops += boolCount(h.SawFlop)
+		showdowns += boolCount(h.Showdown)
+		won += boolCount(h.WonShowdown)
+		out.Net += h.Net
+	}
+	out.Vpip = percent(vpip, out.Hands)
+	out.Pfr = percent(pfr, out.Hands)
+	out.ThreeBet = percent(threeBets, threeBetChances)
+	out.WentToShowdown = percent(showdowns, flops)
+	out.WonAtShowdown = percent(won, showdowns)
+	// with no calls the factor is the number of bets and raises
+	out.AggressionFactor = float64(aggressive)
+	if calls > 0 {
+		out.AggressionFactor = float64(aggressive) / float64(calls)
+	}
+	return out, nil
+}
+
+func boolCount(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// percent returns 0 when there's nothing to take a percentage of
+func percent(n, of int64) float64 {
+	if of == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(of)
+}
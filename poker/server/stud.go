@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+
+	"grpc_texas_holdem/poker/deck"
+	pb "grpc_texas_holdem/poker/protobufs"
+	"grpc_texas_holdem/poker/server/game_ring"
+)
+
+// 7 players use 49 cards to reach seventh street
+const maxStudPlayers = 7
+
+// studStreets maps each stud betting round to the next
+var studStreets = map[pb.RoundStatus]pb.RoundStatus{
+	pb.RoundStatus_THIRD_STREET:   pb.RoundStatus_FOURTH_STREET,
+	pb.RoundStatus_FOURTH_STREET:  pb.RoundStatus_FIFTH_STREET,
+	pb.RoundStatus_FIFTH_STREET:   pb.RoundStatus_SIXTH_STREET,
+	pb.RoundStatus_SIXTH_STREET:   pb.RoundStatus_SEVENTH_STREET,
+	pb.RoundStatus_SEVENTH_STREET: pb.RoundStatus_SHOW,
+	pb.RoundStatus_SHOW:           pb.RoundStatus_OVER,
+}
+
+func isStud(v pb.GameVariant) bool {
+	return v == pb.GameVariant_SEVEN_CARD_STUD || v == pb.GameVariant_RAZZ
+}
+
+// isStudStreet returns true for the stud streets that are dealt after third street
+func isStudStreet(status pb.RoundStatus) bool {
+	switch status {
+	case pb.RoundStatus_FOURTH_STREET, pb.RoundStatus_FIFTH_STREET, pb.RoundStatus_SIXTH_STREET, pb.RoundStatus_SEVENTH_STREET:
+		return true
+	}
+	return false
+}
+
+// firstBettingRound returns the status of the first round of betting for the variant
+func firstBettingRound(v pb.GameVariant) pb.RoundStatus {
+	if isStud(v) {
+		return pb.RoundStatus_THIRD_STREET
+	}
+	return pb.RoundStatus_PRE_FLOP
+}
+
+// DealStudStreet deals the round's current street to each player in hand.
+// Fourth through sixth street are dealt face up and seventh street face down.
+func (s *Server) DealStudStreet(ctx context.Context, r *pb.Round) (*pb.Round, error) {
+	r, err := s.getRound(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !isStudStreet(r.GetStatus()) {
+		return nil, ErrWrongBetStatus
+	}
+
+	d, err := deck.ParseDeck(r.GetDeck())
+	if err != nil {
+		return nil, err
+	}
+
+	inHand := []*pb.Player{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if !p.GetInHand() {
+			continue
+		}
+		if p.GetCards() == "" {
+			return nil, ErrNoExistingCards
+		}
+		inHand = append(inHand, p)
+	}
+	if len(d) < len(inHand) {
+		return nil, ErrNotEnoughCards
+	}
+
+	var c deck.Card
+	for _, p := range inHand {
+		c, d = deck.DealCard(d)
+		dealt := &pb.HandEvent{
+			Game:   r.GetGame(),
+			Round:  r.GetId(),
+			Type:   pb.HandEventType_DEAL,
+			Status: r.GetStatus(),
+			Player: p.GetId(),
+			Slot:   p.GetSlot(),
+			Cards:  c.String(),
+		}
+		p.Cards += c.String()
+		if r.GetStatus() != pb.RoundStatus_SEVENTH_STREET {
+			p.UpCards += c.String()
+			dealt.UpCards = c.String()
+		}
+		if err := s.logEvent(dealt); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.UpdatePlayersCards(ctx, &pb.Players{Players: inHand}); err != nil {
+		return nil, err
+	}
+
+	r.Deck = d.String()
+	return s.UpdateDeck(ctx, r)
+}
+
+// postBringIn starts the betting on third street with a forced bet of the small blind
+// from the lowest up card (highest for razz) instead of posting blinds
+func (s *Server) postBringIn(ctx context.Context, r *pb.Round, game *pb.Game, ring *game_ring.GameRing) (*pb.Round, error) {
+	players, err := ring.InHandFromLeftOfDealer()
+	if err != nil {
+		return nil, err
+	}
+
+	upCards := []deck.Card{}
+	for _, p := range players {
+		c, err := deck.ParseCard(p.GetUpCards())
+		if err != nil {
+			return nil, err
+		}
+		upCards = append(upCards, c)
+	}
+
+	var i int
+	if r.GetVariant() == pb.GameVariant_RAZZ {
+		i = deck.RazzBringIn(upCards)
+	} else {
+		i = deck.BringIn(upCards)
+	}
+	bringIn := players[i]
+
+	r.Action = bringIn.GetSlot()
+	r, err = s.SetAction(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	small, _ := gameBlinds(game)
+	bet := &pb.Bet{
+		Status: r.GetStatus(),
+		Round:  r.GetId(),
+		Game:   r.GetGame(),
+		Player: bringIn.GetId(),
+		Chips:  small,
+		Type:   pb.Bet_BRING_IN,
+	}
+	if _, err := s.MakeBet(ctx, bet); err != nil {
+		return nil, err
+	}
+
+	r, err = s.getRound(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateRoundStatus(ctx, r)
+}
+
+// bestShowing returns the player with the best hand showing, who acts first after third street.
+// This replaces GameRing.FirstToAct for stud games
+func (s *Server) bestShowing(ctx context.Context, r *pb.Round) (*pb.Player, error) {
+	g, err := s.GetGame(ctx, &pb.Game{Id: r.GetGame()})
+	if err != nil {
+		return nil, err
+	}
+	ring, err := game_ring.NewRing(g)
+	if err != nil {
+		return nil, err
+	}
+	players, err := ring.InHandFromLeftOfDealer()
+	if err != nil {
+		return nil, err
+	}
+
+	showing := []deck.Hand{}
+	for _, p := range players {
+		cards, err := deck.ParseHand(p.GetUpCards())
+		if err != nil {
+			return nil, err
+		}
+		showing = append(showing, cards)
+	}
+
+	best := deck.BestShowing(showing)
+	if r.GetVariant() == pb.GameVariant_RAZZ {
+		best = deck.BestShowingLow(showing)
+	}
+	// a player all in has nothing to act with, so the next player round the table acts first
+	for i := range players {
+		if p := players[(best+i)%len(players)]; p.GetChips() > 0 {
+			return p, nil
+		}
+	}
+	return players[best], nil
+}
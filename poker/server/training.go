@@ -0,0 +1,58 @@
+package server
+
+import (
+	"grpc_texas_holdem/poker/deck"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// roundOuts works out the outs of each player in hand on the flop and turn of a hold'em round,
+// other streets and variants have no outs
+func roundOuts(r *pb.Round) ([]*pb.PlayerOuts, error) {
+	if v := r.GetVariant(); v != pb.GameVariant_HOLDEM && v != pb.GameVariant_HOLDEM_HI_LO {
+		return nil, nil
+	}
+	board, err := deck.ParseHand(r.GetFlop() + r.GetRiver() + r.GetTurn())
+	if err != nil {
+		return nil, err
+	}
+	if len(board) != 3 && len(board) != 4 {
+		return nil, nil
+	}
+
+	players := []*pb.Player{}
+	hands := []deck.Hand{}
+	for _, p := range r.GetPlayers().GetPlayers() {
+		if !p.GetInHand() || p.GetCards() == "" {
+			continue
+		}
+		hand, err := deck.ParseHand(p.GetCards())
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, p)
+		hands = append(hands, hand)
+	}
+	if len(hands) < 2 {
+		return nil, nil
+	}
+
+	outs, err := deck.Outs(hands, board)
+	if err != nil {
+		return nil, err
+	}
+	out := []*pb.PlayerOuts{}
+	for i, o := range outs {
+		draws := []pb.Draw{}
+		for _, d := range o.Draws {
+			draws = append(draws, pb.Draw(d))
+		}
+		out = append(out, &pb.PlayerOuts{
+			Player:      players[i].GetId(),
+			Leading:     o.Leading,
+			Outs:        o.Outs.String(),
+			Draws:       draws,
+			Probability: o.Probability,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"grpc_texas_holdem/poker/deck"
+	"grpc_texas_holdem/poker/models"
+	pb "grpc_texas_holdem/poker/protobufs"
+)
+
+// VerifyRound recomputes a completed round's deck from its revealed seeds.
+// The round is valid when the deck matches the commitment published before the
+// hand, and dealing the round's event log again off the end of that deck, burns
+// included, deals every card in the order it was dealt, the round's player cards
+// and boards, and leaves the round's remaining deck.
+func (s *Server) VerifyRound(ctx context.Context, in *pb.Round) (*pb.Verification, error) {
+	r := &models.Round{}
+	if err := s.gormDb.Where("id = ?", in.GetId()).Find(r).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	} else if err == gorm.ErrRecordNotFound {
+		return nil, ErrGameDoesntExist
+	}
+
+	round := r.ProtoMarshal()
+	if round.GetStatus() != pb.RoundStatus_OVER {
+		return nil, ErrRoundNotOver
+	}
+
+	d, valid := deck.Verify(newDeck(round.GetVariant()), round.GetServerSeed(), round.GetClientSeed(), round.GetCommitment())
+	if valid {
+		var err error
+		if valid, err = s.dealtFromDeck(ctx, d, round); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.Verification{
+		Round:      round.GetId(),
+		Valid:      valid,
+		Commitment: round.GetCommitment(),
+		ServerSeed: round.GetServerSeed(),
+		ClientSeed: round.GetClientSeed(),
+		Deck:       d.String(),
+	}, nil
+}
+
+// dealtFromDeck reports whether the round's cards were dealt from the deck, by replaying its
+// deals and checking they match what the round recorded as dealt
+func (s *Server) dealtFromDeck(ctx context.Context, d deck.Deck, round *pb.Round) (bool, error) {
+	events, err := s.HandEvents(ctx, round.GetId())
+	if err != nil {
+		return false, err
+	}
+	left, hands, boards, ok := replayDeal(d, round.GetVariant(), events)
+	if !ok || left.String() != round.GetDeck() {
+		return false, nil
+	}
+
+	// the first board is the round's, any others are the extra runs
+	board := round.GetFlop() + round.GetRiver() + round.GetTurn()
+	if len(round.GetRunBoards()) > 0 {
+		board = strings.Join(round.GetRunBoards(), ",")
+	}
+	if board != strings.Join(boards, ",") {
+		return false, nil
+	}
+
+	var players []*models.RoundPlayers
+	if err := s.gormDb.Where("round = ?", round.GetId()).Find(&players).Error; err != nil {
+		return false, err
+	}
+	// the players' cards are recorded as they were when the round was settled
+	if len(players) != len(hands) {
+		return false, nil
+	}
+	for _, p := range players {
+		if hands[p.Player] != p.Cards {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// replayDeal deals the cards of the event log's deals off the end of the deck, burning wherever the
+// dealer burns, and reports whether each card dealt was the one logged. It returns what's left of the
+// deck, the cards dealt to each player, and the boards dealt, the first board then any extra runs
+func replayDeal(d deck.Deck, variant pb.GameVariant, events []*pb.HandEvent) (deck.Deck, map[int64]string, []string, bool) {
+	hands := map[int64]string{}
+	boards := []string{""}
+
+	// deal takes the cards off the deck, they must be the next cards in it
+	deal := func(cards deck.Hand) bool {
+		for _, want := range cards {
+			if len(d) == 0 {
+				return false
+			}
+			var c deck.Card
+			c, d = deck.DealCard(d)
+			if c != want {
+				return false
+			}
+		}
+		return true
+	}
+	burn := func() bool {
+		if len(d) == 0 {
+			return false
+		}
+		_, d = deck.DealCard(d)
+		return true
+	}
+
+	// stud is dealt without burn cards
+	if !isStud(variant) && !burn() {
+		return d, nil, nil, false
+	}
+	for _, e := range events {
+		if e.GetType() != pb.HandEventType_DEAL {
+			continue
+		}
+		cards, err := deck.ParseHand(e.GetCards())
+		if err != nil {
+			return d, nil, nil, false
+		}
+
+		switch {
+		case e.GetPlayer() != 0:
+			if !deal(cards) {
+				return d, nil, nil, false
+			}
+			hands[e.GetPlayer()] += e.GetCards()
+		case len(cards) == boardCards[e.GetStatus()]:
+			if !burn() || !deal(cards) {
+				return d, nil, nil, false
+			}
+			boards[0] += e.GetCards()
+		default:
+			// an extra run shares the first board's cards dealt before everyone was all in,
+			// then burns and deals each street left
+			first := deck.NewHand(boards[0])
+			shared := 0
+			for shared < len(first) && shared < len(cards) && first[shared] == cards[shared] {
+				shared++
+			}
+			dealt := 0
+			for status := pb.RoundStatus_PRE_FLOP; status != pb.RoundStatus_TURN; {
+				status = boardStreets[status]
+				n := boardCards[status]
+				if dealt >= shared {
+					if dealt+n > len(cards) || !burn() || !deal(cards[dealt:dealt+n]) {
+						return d, nil, nil, false
+					}
+				}
+				dealt += n
+			}
+			if dealt != len(cards) {
+				return d, nil, nil, false
+			}
+			boards = append(boards, e.GetCards())
+		}
+	}
+	return d, hands, boards, true
+}